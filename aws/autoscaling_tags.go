@@ -249,9 +249,13 @@ func autoscalingTagFromMap(attr map[string]interface{}, resourceID string) (*aut
 }
 
 // autoscalingTagDescriptionsToSlice turns the list of tags into a slice.
-func autoscalingTagDescriptionsToSlice(ts []*autoscaling.TagDescription) []map[string]interface{} {
+func autoscalingTagDescriptionsToSlice(ts []*autoscaling.TagDescription, ignoreConfig *IgnoreTagsConfig) []map[string]interface{} {
 	tags := make([]map[string]interface{}, 0, len(ts))
 	for _, t := range ts {
+		if ignoreConfig.Ignored(*t.Key) {
+			continue
+		}
+
 		tags = append(tags, map[string]interface{}{
 			"key":                 *t.Key,
 			"value":               *t.Value,
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mockaws"
+)
+
+// testMockAwsAccountID is the account ID of clients returned by newTestMockAws.
+const testMockAwsAccountID = "123456789012"
+
+// testMockAwsServiceProtocols are the protocols of the services that can be
+// stubbed with newTestMockAws, keyed by provider endpoint name.
+var testMockAwsServiceProtocols = map[string]mockaws.Protocol{
+	"dynamodb": mockaws.ProtocolJSON,
+	"ec2":      mockaws.ProtocolEC2,
+	"ecs":      mockaws.ProtocolJSON,
+	"efs":      mockaws.ProtocolRESTJSON,
+	"iam":      mockaws.ProtocolQuery,
+	"kinesis":  mockaws.ProtocolJSON,
+	"lambda":   mockaws.ProtocolRESTJSON,
+	"rds":      mockaws.ProtocolQuery,
+	"s3":       mockaws.ProtocolRESTXML,
+	"sns":      mockaws.ProtocolQuery,
+	"sqs":      mockaws.ProtocolQuery,
+	"ssm":      mockaws.ProtocolJSON,
+	"sts":      mockaws.ProtocolQuery,
+}
+
+// testMockAws is an AWSClient backed by an in-process mock AWS server.
+// Unlike acceptance tests, tests using it run offline with plain go test.
+type testMockAws struct {
+	*mockaws.Server
+
+	client *AWSClient
+}
+
+// newTestMockAws returns an AWSClient whose clients for the given services
+// send requests to a new mock AWS server. Clients for all other services
+// also use the server but receive an error for every request.
+// Callers must defer Close.
+func newTestMockAws(t *testing.T, services ...string) *testMockAws {
+	server := mockaws.NewServer()
+
+	endpoints := make(map[string]string)
+	for _, name := range endpointServiceNames {
+		endpoints[name] = server.URL() + "/" + name
+	}
+
+	for _, service := range services {
+		protocol, ok := testMockAwsServiceProtocols[service]
+		if !ok {
+			server.Close()
+			t.Fatalf("no mock AWS protocol configured for service %q", service)
+		}
+
+		endpoints[service] = server.Endpoint(service, protocol)
+	}
+
+	config := &Config{
+		AccessKey:               "mock-access-key",
+		SecretKey:               "mock-secret-key",
+		Region:                  "us-west-2",
+		MaxRetries:              3,
+		Endpoints:               endpoints,
+		S3ForcePathStyle:        true,
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		server.Close()
+		t.Fatalf("error creating mock AWS client: %s", err)
+	}
+
+	awsClient := client.(*AWSClient)
+	awsClient.accountid = testMockAwsAccountID
+
+	return &testMockAws{
+		Server: server,
+		client: awsClient,
+	}
+}
+
+// Close shuts down the mock AWS server and fails the test if any request
+// was not answered by a stub.
+func (m *testMockAws) Close(t *testing.T) {
+	defer m.Server.Close()
+
+	for _, r := range m.Unmatched() {
+		t.Errorf("unexpected mock AWS request: %s %s %s: %s", r.Service, r.Method, r.Operation, r.Body)
+	}
+}
@@ -1,14 +1,56 @@
 package aws
 
 import (
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"log"
 	"os"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
+const (
+	// sweepDryRunEnvVar enables dry-run mode. Resources that would be
+	// deleted are printed as a JSON report instead of being deleted.
+	sweepDryRunEnvVar = "SWEEP_DRY_RUN"
+
+	// sweepNamePrefixEnvVar is a comma-separated list of name prefixes.
+	// When set, only resources whose name starts with one of them are swept.
+	sweepNamePrefixEnvVar = "SWEEP_NAME_PREFIX"
+
+	// sweepTagEnvVar restricts sweeping to resources with the given tag.
+	// The value is either KEY or KEY=VALUE.
+	sweepTagEnvVar = "SWEEP_TAG"
+
+	// sweepMinAgeEnvVar restricts sweeping to resources created at least
+	// this long ago. The value is a Go duration, e.g. 2h.
+	sweepMinAgeEnvVar = "SWEEP_MIN_AGE"
+
+	// sweepDefaultRetryTimeout is how long deletion is retried on
+	// retryable errors when a sweeper does not set RetryTimeout.
+	sweepDefaultRetryTimeout = 1 * time.Minute
+)
+
 func TestMain(m *testing.M) {
+	flag.Parse()
+
+	// In dry-run mode only sweepers registered with addTestSweeper are run,
+	// as any other sweeper, including one reached as a dependency, would
+	// delete resources instead of reporting them.
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" && testSweepDryRun() {
+		if err := testSweepDryRunRegions(strings.Split(regions, ","), flag.Lookup("sweep-run").Value.String()); err != nil {
+			log.Fatalf("[ERR] %s", err)
+		}
+		return
+	}
+
 	resource.TestMain(m)
 }
 
@@ -32,3 +74,614 @@ func sharedClientForRegion(region string) (interface{}, error) {
 
 	return client, nil
 }
+
+// testSweepers holds all sweepers registered with addTestSweeper, keyed by
+// Terraform resource type.
+var testSweepers = make(map[string]*testSweeper)
+
+// testSweepResource is a single resource returned by a sweeper's List function.
+type testSweepResource struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+
+	// Prepare, if set, is called for every matched resource before any of
+	// them is deleted, e.g. to remove references between resources of the
+	// same type. It is not called in dry-run mode.
+	Prepare func() error `json:"-"`
+
+	// Delete removes the resource and any sub-resources blocking its
+	// deletion. It is not called in dry-run mode.
+	Delete func() error `json:"-"`
+}
+
+// testSweeper describes how to list and delete leftover resources of a
+// single Terraform resource type.
+type testSweeper struct {
+	// Name is the Terraform resource type, e.g. aws_vpc.
+	Name string
+
+	// Description is used in log and error messages, e.g. EC2 VPC.
+	Description string
+
+	// Dependencies are the names of sweepers that must run first.
+	Dependencies []string
+
+	// List returns the candidate resources in the client's region. It should
+	// omit resources that must never be swept, such as a default VPC.
+	List func(client *AWSClient) ([]*testSweepResource, error)
+
+	// NamePrefixes restricts sweeping to resources whose name starts with
+	// one of them. An empty list matches all names.
+	NamePrefixes []string
+
+	// RetryableErrorCodes are AWS error codes returned by Delete that are
+	// retried until RetryTimeout, e.g. DependencyViolation.
+	RetryableErrorCodes []string
+
+	// RetryTimeout defaults to sweepDefaultRetryTimeout.
+	RetryTimeout time.Duration
+}
+
+// testSweepReport is the JSON document printed for each sweeper in dry-run mode.
+type testSweepReport struct {
+	Region       string               `json:"region"`
+	ResourceType string               `json:"resource_type"`
+	Resources    []*testSweepResource `json:"resources"`
+}
+
+// testSweepFilter narrows the resources selected by a sweeper.
+// Resources missing the information a filter needs never match it.
+type testSweepFilter struct {
+	NamePrefixes []string
+	TagKey       string
+	TagValue     string
+	MinAge       time.Duration
+}
+
+// addTestSweeper registers a sweeper with the acceptance test framework.
+func addTestSweeper(s *testSweeper) {
+	testSweepers[s.Name] = s
+
+	resource.AddTestSweepers(s.Name, &resource.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		F:            s.sweep,
+	})
+}
+
+func (s *testSweeper) sweep(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	return s.sweepWithClient(client.(*AWSClient), region)
+}
+
+func (s *testSweeper) sweepWithClient(client *AWSClient, region string) error {
+	filter, err := testSweepFilterFromEnv()
+	if err != nil {
+		return err
+	}
+
+	resources, err := s.List(client)
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping %s sweep for %s: %s", s.Description, region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing %s: %s", s.Description, err)
+	}
+
+	matched := make([]*testSweepResource, 0, len(resources))
+	for _, r := range resources {
+		if !testSweepNameHasPrefix(r.Name, s.NamePrefixes) || !filter.match(r, time.Now()) {
+			log.Printf("[DEBUG] Skipping %s: %s", s.Description, r.ID)
+			continue
+		}
+
+		matched = append(matched, r)
+	}
+
+	if testSweepDryRun() {
+		return testSweepPrintReport(&testSweepReport{
+			Region:       region,
+			ResourceType: s.Name,
+			Resources:    matched,
+		})
+	}
+
+	if len(matched) == 0 {
+		log.Printf("[DEBUG] No %s to sweep", s.Description)
+		return nil
+	}
+
+	var errs *multierror.Error
+	for _, r := range matched {
+		if r.Prepare == nil {
+			continue
+		}
+
+		log.Printf("[DEBUG] Preparing %s for deletion: %s", s.Description, r.ID)
+
+		if err := r.Prepare(); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error preparing %s (%s) for deletion: %s", s.Description, r.ID, err))
+		}
+	}
+
+	for _, r := range matched {
+		log.Printf("[INFO] Deleting %s: %s", s.Description, r.ID)
+
+		if err := s.delete(r); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting %s (%s): %s", s.Description, r.ID, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (s *testSweeper) delete(r *testSweepResource) error {
+	timeout := s.RetryTimeout
+	if timeout == 0 {
+		timeout = sweepDefaultRetryTimeout
+	}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		err := r.Delete()
+
+		for _, code := range s.RetryableErrorCodes {
+			if isAWSErr(err, code, "") {
+				return resource.RetryableError(err)
+			}
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		err = r.Delete()
+	}
+
+	return err
+}
+
+func (f *testSweepFilter) match(r *testSweepResource, now time.Time) bool {
+	if !testSweepNameHasPrefix(r.Name, f.NamePrefixes) {
+		return false
+	}
+
+	if f.TagKey != "" {
+		v, ok := r.Tags[f.TagKey]
+		if !ok || (f.TagValue != "" && v != f.TagValue) {
+			return false
+		}
+	}
+
+	if f.MinAge > 0 {
+		if r.CreatedAt == nil || now.Sub(*r.CreatedAt) < f.MinAge {
+			return false
+		}
+	}
+
+	return true
+}
+
+func testSweepFilterFromEnv() (*testSweepFilter, error) {
+	filter := &testSweepFilter{}
+
+	if v := os.Getenv(sweepNamePrefixEnvVar); v != "" {
+		for _, prefix := range strings.Split(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				filter.NamePrefixes = append(filter.NamePrefixes, prefix)
+			}
+		}
+	}
+
+	if v := os.Getenv(sweepTagEnvVar); v != "" {
+		parts := strings.SplitN(v, "=", 2)
+		filter.TagKey = parts[0]
+		if len(parts) == 2 {
+			filter.TagValue = parts[1]
+		}
+	}
+
+	if v := os.Getenv(sweepMinAgeEnvVar); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s (%s): %s", sweepMinAgeEnvVar, v, err)
+		}
+		filter.MinAge = minAge
+	}
+
+	return filter, nil
+}
+
+func testSweepNameHasPrefix(name string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func testSweepDryRun() bool {
+	switch strings.ToLower(os.Getenv(sweepDryRunEnvVar)) {
+	case "", "0", "false":
+		return false
+	}
+
+	return true
+}
+
+func testSweepPrintReport(report *testSweepReport) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling %s sweep report: %s", report.ResourceType, err)
+	}
+
+	fmt.Println(string(b))
+
+	return nil
+}
+
+// testSweepDryRunRegions reports the resources matched by the registered
+// sweepers selected by run, a comma-separated list of name substrings as
+// accepted by -sweep-run, in each region.
+func testSweepDryRunRegions(regions []string, run string) error {
+	order, skipped, err := testSweepDryRunPlan(run, testSweepers)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range skipped {
+		log.Printf("[WARN] Skipping sweeper (%s) in dry-run mode: not registered with addTestSweeper", name)
+	}
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		for _, name := range order {
+			if err := testSweepers[name].sweep(region); err != nil {
+				return fmt.Errorf("error running sweeper (%s) in region (%s): %s", name, region, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testSweepDryRunPlan returns the sweepers selected by run together with
+// their dependencies, in the order they must run. Dependencies that are not
+// in the map are returned separately so they can be reported as skipped.
+func testSweepDryRunPlan(run string, sweepers map[string]*testSweeper) ([]string, []string, error) {
+	var filters []string
+	for _, f := range strings.Split(strings.ToLower(run), ",") {
+		if f != "" {
+			filters = append(filters, f)
+		}
+	}
+
+	selected := make(map[string]*testSweeper)
+	skipped := make(map[string]bool)
+
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := selected[name]; ok {
+			return
+		}
+
+		s, ok := sweepers[name]
+		if !ok {
+			skipped[name] = true
+			return
+		}
+
+		selected[name] = s
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range sweepers {
+		if len(filters) == 0 {
+			add(name)
+			continue
+		}
+
+		for _, f := range filters {
+			if strings.Contains(strings.ToLower(name), f) {
+				add(name)
+			}
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil, nil, errors.New("no sweepers registered with addTestSweeper match -sweep-run")
+	}
+
+	order, err := testSweeperOrder(selected)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skippedNames := make([]string, 0, len(skipped))
+	for name := range skipped {
+		skippedNames = append(skippedNames, name)
+	}
+	sort.Strings(skippedNames)
+
+	return order, skippedNames, nil
+}
+
+// testSweeperOrder returns the names of the given sweepers ordered so that
+// every sweeper follows its dependencies. Dependencies that are not in the
+// map are ignored. An error is returned if the dependencies form a cycle.
+func testSweeperOrder(sweepers map[string]*testSweeper) ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	state := make(map[string]int, len(sweepers))
+	order := make([]string, 0, len(sweepers))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("sweeper dependency cycle: %s -> %s", strings.Join(path, " -> "), name)
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		dependencies := append([]string(nil), sweepers[name].Dependencies...)
+		sort.Strings(dependencies)
+
+		for _, dependency := range dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				continue
+			}
+
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+func TestSweeperOrder(t *testing.T) {
+	sweepers := map[string]*testSweeper{
+		"aws_vpc": {
+			Name:         "aws_vpc",
+			Dependencies: []string{"aws_subnet", "aws_security_group"},
+		},
+		"aws_subnet": {
+			Name:         "aws_subnet",
+			Dependencies: []string{"aws_db_subnet_group", "aws_not_registered"},
+		},
+		"aws_security_group": {
+			Name:         "aws_security_group",
+			Dependencies: []string{"aws_subnet"},
+		},
+		"aws_db_subnet_group": {
+			Name: "aws_db_subnet_group",
+		},
+	}
+
+	order, err := testSweeperOrder(sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"aws_db_subnet_group", "aws_subnet", "aws_security_group", "aws_vpc"}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected order %v, got %v", expected, order)
+	}
+
+	sweepers["aws_db_subnet_group"].Dependencies = []string{"aws_vpc"}
+
+	if _, err := testSweeperOrder(sweepers); err == nil {
+		t.Fatal("expected dependency cycle error, got none")
+	}
+}
+
+func TestSweeperOrder_registered(t *testing.T) {
+	if _, err := testSweeperOrder(testSweepers); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSweepDryRunPlan(t *testing.T) {
+	sweepers := map[string]*testSweeper{
+		"aws_vpc": {
+			Name:         "aws_vpc",
+			Dependencies: []string{"aws_subnet", "aws_vpn_gateway"},
+		},
+		"aws_subnet": {
+			Name:         "aws_subnet",
+			Dependencies: []string{"aws_instance"},
+		},
+		"aws_db_subnet_group": {
+			Name: "aws_db_subnet_group",
+		},
+	}
+
+	order, skipped, err := testSweepDryRunPlan("aws_vpc", sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"aws_subnet", "aws_vpc"}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected order %v, got %v", expected, order)
+	}
+
+	expected = []string{"aws_instance", "aws_vpn_gateway"}
+	if strings.Join(skipped, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected skipped %v, got %v", expected, skipped)
+	}
+
+	order, _, err = testSweepDryRunPlan("", sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected = []string{"aws_db_subnet_group", "aws_subnet", "aws_vpc"}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected order %v, got %v", expected, order)
+	}
+
+	if _, _, err := testSweepDryRunPlan("aws_instance", sweepers); err == nil {
+		t.Fatal("expected error for unregistered sweeper, got none")
+	}
+}
+
+func TestSweeperSweep_dryRun(t *testing.T) {
+	oldvar := os.Getenv(sweepDryRunEnvVar)
+	os.Setenv(sweepDryRunEnvVar, "1")
+	defer os.Setenv(sweepDryRunEnvVar, oldvar)
+
+	fail := func() error {
+		t.Fatal("resource modified in dry-run mode")
+		return nil
+	}
+
+	s := &testSweeper{
+		Name:        "aws_vpc",
+		Description: "EC2 VPC",
+		List: func(client *AWSClient) ([]*testSweepResource, error) {
+			return []*testSweepResource{
+				{ID: "vpc-12345678", Prepare: fail, Delete: fail},
+				{ID: "vpc-87654321", Delete: fail},
+			}, nil
+		},
+	}
+
+	if err := s.sweepWithClient(&AWSClient{}, "us-west-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestSweepFilterMatch(t *testing.T) {
+	now := time.Date(2019, time.August, 1, 12, 0, 0, 0, time.UTC)
+	created := now.Add(-3 * time.Hour)
+
+	r := &testSweepResource{
+		ID:        "vpc-12345678",
+		Name:      "tf-acc-test-12345",
+		Tags:      map[string]string{"Name": "tf-acc-test-12345", "Owner": "ci"},
+		CreatedAt: &created,
+	}
+
+	testCases := []struct {
+		name     string
+		filter   *testSweepFilter
+		resource *testSweepResource
+		expected bool
+	}{
+		{
+			name:     "empty filter",
+			filter:   &testSweepFilter{},
+			resource: r,
+			expected: true,
+		},
+		{
+			name:     "name prefix match",
+			filter:   &testSweepFilter{NamePrefixes: []string{"terraform-", "tf-acc-test"}},
+			resource: r,
+			expected: true,
+		},
+		{
+			name:     "name prefix mismatch",
+			filter:   &testSweepFilter{NamePrefixes: []string{"terraform-"}},
+			resource: r,
+			expected: false,
+		},
+		{
+			name:     "tag key match",
+			filter:   &testSweepFilter{TagKey: "Owner"},
+			resource: r,
+			expected: true,
+		},
+		{
+			name:     "tag value match",
+			filter:   &testSweepFilter{TagKey: "Owner", TagValue: "ci"},
+			resource: r,
+			expected: true,
+		},
+		{
+			name:     "tag value mismatch",
+			filter:   &testSweepFilter{TagKey: "Owner", TagValue: "prod"},
+			resource: r,
+			expected: false,
+		},
+		{
+			name:     "tag key missing",
+			filter:   &testSweepFilter{TagKey: "Environment"},
+			resource: r,
+			expected: false,
+		},
+		{
+			name:     "old enough",
+			filter:   &testSweepFilter{MinAge: 2 * time.Hour},
+			resource: r,
+			expected: true,
+		},
+		{
+			name:     "too new",
+			filter:   &testSweepFilter{MinAge: 4 * time.Hour},
+			resource: r,
+			expected: false,
+		},
+		{
+			name:     "unknown age",
+			filter:   &testSweepFilter{MinAge: time.Minute},
+			resource: &testSweepResource{ID: "sg-12345678"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.filter.match(tc.resource, now); got != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -1,14 +1,22 @@
 package aws
 
 import (
+	"crypto/tls"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/apigateway"
@@ -130,10 +138,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apilogging"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
 )
 
+// assumeRoleExpiryWindow is how long before they expire that assumed role
+// credentials are refreshed.
+const assumeRoleExpiryWindow = 1 * time.Minute
+
 type Config struct {
 	AccessKey     string
 	SecretKey     string
@@ -143,16 +158,19 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	// AssumeRoles are assumed in order, each with the credentials of the
+	// previous role
+	AssumeRoles               []*AssumeRole
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints map[string]string
-	Insecure  bool
+	APIRateLimits     map[string]ratelimit.Config
+	DefaultTagsConfig *DefaultTagsConfig
+	Endpoints         map[string]string
+	IgnoreTagsConfig  *IgnoreTagsConfig
+	Insecure          bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -160,10 +178,15 @@ type Config struct {
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
+
+	// SensitiveAttributeNames are redacted from API request logs
+	SensitiveAttributeNames []string
 }
 
 type AWSClient struct {
 	accountid                           string
+	config                              *Config
+	regionalClients                     *awsClientCache
 	acmconn                             *acm.ACM
 	acmpcaconn                          *acmpca.ACMPCA
 	apigateway                          *apigateway.APIGateway
@@ -198,6 +221,7 @@ type AWSClient struct {
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
 	daxconn                             *dax.DAX
+	defaultTagsConfig                   *DefaultTagsConfig
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
 	dmsconn                             *databasemigrationservice.DatabaseMigrationService
@@ -226,6 +250,7 @@ type AWSClient struct {
 	glueconn                            *glue.Glue
 	guarddutyconn                       *guardduty.GuardDuty
 	iamconn                             *iam.IAM
+	ignoreTagsConfig                    *IgnoreTagsConfig
 	inspectorconn                       *inspector.Inspector
 	iotconn                             *iot.IoT
 	kafkaconn                           *kafka.Kafka
@@ -302,12 +327,7 @@ func (c *Config) Client() (interface{}, error) {
 	log.Println("[INFO] Building AWS auth structure")
 	awsbaseConfig := &awsbase.Config{
 		AccessKey:               c.AccessKey,
-		AssumeRoleARN:           c.AssumeRoleARN,
-		AssumeRoleExternalID:    c.AssumeRoleExternalID,
-		AssumeRolePolicy:        c.AssumeRolePolicy,
-		AssumeRoleSessionName:   c.AssumeRoleSessionName,
 		CredsFilename:           c.CredsFilename,
-		DebugLogging:            logging.IsDebugOrHigher(),
 		IamEndpoint:             c.Endpoints["iam"],
 		Insecure:                c.Insecure,
 		MaxRetries:              c.MaxRetries,
@@ -326,11 +346,39 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	// Assumed role credentials are retrieved before creating the session, so
+	// it is validated against, and returns the account ID of, the last role
+	var assumeRoleCreds *credentials.Credentials
+	if c.AssumeRoleWithWebIdentity != nil || len(c.AssumeRoles) > 0 {
+		creds, value, err := c.assumeRoleCredentials(awsbaseConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		assumeRoleCreds = creds
+		awsbaseConfig.AccessKey = value.AccessKeyID
+		awsbaseConfig.SecretKey = value.SecretAccessKey
+		awsbaseConfig.Token = value.SessionToken
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	// Installed before the session is copied, including for the service
+	// clients, so each copy inherits them
+	c.installRequestHandlers(&sess.Handlers)
+
+	if assumeRoleCreds != nil {
+		// Refresh the temporary credentials when they expire
+		sess = sess.Copy(&aws.Config{Credentials: assumeRoleCreds})
+
+		if accountID == "" {
+			accountID = c.assumeRoleAccountID()
+		}
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -339,127 +387,142 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	// Each service client is created from a copy of the session with its
+	// endpoint and API rate limiter, both keyed by the endpoints block names
+	limiters := ratelimit.NewLimiters(c.APIRateLimits)
+	serviceSession := func(service string, configs ...*aws.Config) *session.Session {
+		serviceSess := sess.Copy(append([]*aws.Config{{Endpoint: aws.String(c.Endpoints[service])}}, configs...)...)
+		limiters.InstallHandlers(service, &serviceSess.Handlers)
+		return serviceSess
+	}
+
+	config := *c
+
 	client := &AWSClient{
 		accountid:                           accountID,
-		acmconn:                             acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])})),
-		acmpcaconn:                          acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acmpca"])})),
-		apigateway:                          apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		apigatewayv2conn:                    apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		appautoscalingconn:                  applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationautoscaling"])})),
-		applicationinsightsconn:             applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationinsights"])})),
-		appmeshconn:                         appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appmesh"])})),
-		appsyncconn:                         appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appsync"])})),
-		athenaconn:                          athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["athena"])})),
-		autoscalingconn:                     autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscaling"])})),
-		autoscalingplansconn:                autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscalingplans"])})),
-		backupconn:                          backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["backup"])})),
-		batchconn:                           batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["batch"])})),
-		budgetconn:                          budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["budgets"])})),
-		cfconn:                              cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudformation"])})),
-		cloud9conn:                          cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloud9"])})),
-		cloudfrontconn:                      cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudfront"])})),
-		cloudhsmv2conn:                      cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudhsm"])})),
-		cloudsearchconn:                     cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudsearch"])})),
-		cloudtrailconn:                      cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudtrail"])})),
-		cloudwatchconn:                      cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatch"])})),
-		cloudwatcheventsconn:                cloudwatchevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchevents"])})),
-		cloudwatchlogsconn:                  cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchlogs"])})),
-		codebuildconn:                       codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codebuild"])})),
-		codecommitconn:                      codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codecommit"])})),
-		codedeployconn:                      codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codedeploy"])})),
-		codepipelineconn:                    codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codepipeline"])})),
-		cognitoconn:                         cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidentity"])})),
-		cognitoidpconn:                      cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])})),
-		configconn:                          configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])})),
-		costandusagereportconn:              costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])})),
-		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
-		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
-		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
-		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
-		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
-		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
-		docdbconn:                           docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["docdb"])})),
-		dsconn:                              directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ds"])})),
-		dxconn:                              directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["directconnect"])})),
-		dynamodbconn:                        dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dynamodb"])})),
-		ec2conn:                             ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ec2"])})),
-		ecrconn:                             ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecr"])})),
-		ecsconn:                             ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecs"])})),
-		efsconn:                             efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["efs"])})),
-		eksconn:                             eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["eks"])})),
-		elasticacheconn:                     elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticache"])})),
-		elasticbeanstalkconn:                elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticbeanstalk"])})),
-		elastictranscoderconn:               elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elastictranscoder"])})),
-		elbconn:                             elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		elbv2conn:                           elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		emrconn:                             emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emr"])})),
-		esconn:                              elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["es"])})),
-		firehoseconn:                        firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["firehose"])})),
-		fmsconn:                             fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fms"])})),
-		fsxconn:                             fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fsx"])})),
-		gameliftconn:                        gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["gamelift"])})),
-		glacierconn:                         glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glacier"])})),
-		glueconn:                            glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glue"])})),
-		guarddutyconn:                       guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["guardduty"])})),
-		iamconn:                             iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])})),
-		inspectorconn:                       inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["inspector"])})),
-		iotconn:                             iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iot"])})),
-		kafkaconn:                           kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kafka"])})),
-		kinesisanalyticsconn:                kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalytics"])})),
-		kinesisanalyticsv2conn:              kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalytics"])})),
-		kinesisconn:                         kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis"])})),
-		kinesisvideoconn:                    kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisvideo"])})),
-		kmsconn:                             kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kms"])})),
-		lambdaconn:                          lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lambda"])})),
-		lexmodelconn:                        lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lexmodels"])})),
-		licensemanagerconn:                  licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["licensemanager"])})),
-		lightsailconn:                       lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lightsail"])})),
-		macieconn:                           macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie"])})),
-		managedblockchainconn:               managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["managedblockchain"])})),
-		mediaconnectconn:                    mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconnect"])})),
-		mediaconvertconn:                    mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconvert"])})),
-		medialiveconn:                       medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["medialive"])})),
-		mediapackageconn:                    mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediapackage"])})),
-		mediastoreconn:                      mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastore"])})),
-		mediastoredataconn:                  mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastoredata"])})),
-		mqconn:                              mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mq"])})),
-		neptuneconn:                         neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["neptune"])})),
-		opsworksconn:                        opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["opsworks"])})),
-		organizationsconn:                   organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])})),
+		config:                              &config,
+		regionalClients:                     &awsClientCache{clients: make(map[string]*AWSClient)},
+		acmconn:                             acm.New(serviceSession("acm")),
+		acmpcaconn:                          acmpca.New(serviceSession("acmpca")),
+		apigateway:                          apigateway.New(serviceSession("apigateway")),
+		apigatewayv2conn:                    apigatewayv2.New(serviceSession("apigateway")),
+		appautoscalingconn:                  applicationautoscaling.New(serviceSession("applicationautoscaling")),
+		applicationinsightsconn:             applicationinsights.New(serviceSession("applicationinsights")),
+		appmeshconn:                         appmesh.New(serviceSession("appmesh")),
+		appsyncconn:                         appsync.New(serviceSession("appsync")),
+		athenaconn:                          athena.New(serviceSession("athena")),
+		autoscalingconn:                     autoscaling.New(serviceSession("autoscaling")),
+		autoscalingplansconn:                autoscalingplans.New(serviceSession("autoscalingplans")),
+		backupconn:                          backup.New(serviceSession("backup")),
+		batchconn:                           batch.New(serviceSession("batch")),
+		budgetconn:                          budgets.New(serviceSession("budgets")),
+		cfconn:                              cloudformation.New(serviceSession("cloudformation")),
+		cloud9conn:                          cloud9.New(serviceSession("cloud9")),
+		cloudfrontconn:                      cloudfront.New(serviceSession("cloudfront")),
+		cloudhsmv2conn:                      cloudhsmv2.New(serviceSession("cloudhsm")),
+		cloudsearchconn:                     cloudsearch.New(serviceSession("cloudsearch")),
+		cloudtrailconn:                      cloudtrail.New(serviceSession("cloudtrail")),
+		cloudwatchconn:                      cloudwatch.New(serviceSession("cloudwatch")),
+		cloudwatcheventsconn:                cloudwatchevents.New(serviceSession("cloudwatchevents")),
+		cloudwatchlogsconn:                  cloudwatchlogs.New(serviceSession("cloudwatchlogs")),
+		codebuildconn:                       codebuild.New(serviceSession("codebuild")),
+		codecommitconn:                      codecommit.New(serviceSession("codecommit")),
+		codedeployconn:                      codedeploy.New(serviceSession("codedeploy")),
+		codepipelineconn:                    codepipeline.New(serviceSession("codepipeline")),
+		cognitoconn:                         cognitoidentity.New(serviceSession("cognitoidentity")),
+		cognitoidpconn:                      cognitoidentityprovider.New(serviceSession("cognitoidp")),
+		configconn:                          configservice.New(serviceSession("configservice")),
+		costandusagereportconn:              costandusagereportservice.New(serviceSession("cur")),
+		datapipelineconn:                    datapipeline.New(serviceSession("datapipeline")),
+		datasyncconn:                        datasync.New(serviceSession("datasync")),
+		daxconn:                             dax.New(serviceSession("dax")),
+		defaultTagsConfig:                   c.DefaultTagsConfig,
+		devicefarmconn:                      devicefarm.New(serviceSession("devicefarm")),
+		dlmconn:                             dlm.New(serviceSession("dlm")),
+		dmsconn:                             databasemigrationservice.New(serviceSession("dms")),
+		docdbconn:                           docdb.New(serviceSession("docdb")),
+		dsconn:                              directoryservice.New(serviceSession("ds")),
+		dxconn:                              directconnect.New(serviceSession("directconnect")),
+		dynamodbconn:                        dynamodb.New(serviceSession("dynamodb")),
+		ec2conn:                             ec2.New(serviceSession("ec2")),
+		ecrconn:                             ecr.New(serviceSession("ecr")),
+		ecsconn:                             ecs.New(serviceSession("ecs")),
+		efsconn:                             efs.New(serviceSession("efs")),
+		eksconn:                             eks.New(serviceSession("eks")),
+		elasticacheconn:                     elasticache.New(serviceSession("elasticache")),
+		elasticbeanstalkconn:                elasticbeanstalk.New(serviceSession("elasticbeanstalk")),
+		elastictranscoderconn:               elastictranscoder.New(serviceSession("elastictranscoder")),
+		elbconn:                             elb.New(serviceSession("elb")),
+		elbv2conn:                           elbv2.New(serviceSession("elb")),
+		emrconn:                             emr.New(serviceSession("emr")),
+		esconn:                              elasticsearch.New(serviceSession("es")),
+		firehoseconn:                        firehose.New(serviceSession("firehose")),
+		fmsconn:                             fms.New(serviceSession("fms")),
+		fsxconn:                             fsx.New(serviceSession("fsx")),
+		gameliftconn:                        gamelift.New(serviceSession("gamelift")),
+		glacierconn:                         glacier.New(serviceSession("glacier")),
+		glueconn:                            glue.New(serviceSession("glue")),
+		guarddutyconn:                       guardduty.New(serviceSession("guardduty")),
+		iamconn:                             iam.New(serviceSession("iam")),
+		ignoreTagsConfig:                    c.IgnoreTagsConfig,
+		inspectorconn:                       inspector.New(serviceSession("inspector")),
+		iotconn:                             iot.New(serviceSession("iot")),
+		kafkaconn:                           kafka.New(serviceSession("kafka")),
+		kinesisanalyticsconn:                kinesisanalytics.New(serviceSession("kinesisanalytics")),
+		kinesisanalyticsv2conn:              kinesisanalyticsv2.New(serviceSession("kinesisanalytics")),
+		kinesisconn:                         kinesis.New(serviceSession("kinesis")),
+		kinesisvideoconn:                    kinesisvideo.New(serviceSession("kinesisvideo")),
+		kmsconn:                             kms.New(serviceSession("kms")),
+		lambdaconn:                          lambda.New(serviceSession("lambda")),
+		lexmodelconn:                        lexmodelbuildingservice.New(serviceSession("lexmodels")),
+		licensemanagerconn:                  licensemanager.New(serviceSession("licensemanager")),
+		lightsailconn:                       lightsail.New(serviceSession("lightsail")),
+		macieconn:                           macie.New(serviceSession("macie")),
+		managedblockchainconn:               managedblockchain.New(serviceSession("managedblockchain")),
+		mediaconnectconn:                    mediaconnect.New(serviceSession("mediaconnect")),
+		mediaconvertconn:                    mediaconvert.New(serviceSession("mediaconvert")),
+		medialiveconn:                       medialive.New(serviceSession("medialive")),
+		mediapackageconn:                    mediapackage.New(serviceSession("mediapackage")),
+		mediastoreconn:                      mediastore.New(serviceSession("mediastore")),
+		mediastoredataconn:                  mediastoredata.New(serviceSession("mediastoredata")),
+		mqconn:                              mq.New(serviceSession("mq")),
+		neptuneconn:                         neptune.New(serviceSession("neptune")),
+		opsworksconn:                        opsworks.New(serviceSession("opsworks")),
+		organizationsconn:                   organizations.New(serviceSession("organizations")),
 		partition:                           partition,
-		pinpointconn:                        pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pinpoint"])})),
-		pricingconn:                         pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])})),
-		quicksightconn:                      quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["quicksight"])})),
-		ramconn:                             ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
-		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
-		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
+		pinpointconn:                        pinpoint.New(serviceSession("pinpoint")),
+		pricingconn:                         pricing.New(serviceSession("pricing")),
+		quicksightconn:                      quicksight.New(serviceSession("quicksight")),
+		ramconn:                             ram.New(serviceSession("ram")),
+		rdsconn:                             rds.New(serviceSession("rds")),
+		redshiftconn:                        redshift.New(serviceSession("redshift")),
 		region:                              c.Region,
-		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
-		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
-		s3conn:                              s3.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3"]), S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle)})),
-		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
-		sagemakerconn:                       sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sagemaker"])})),
-		scconn:                              servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicecatalog"])})),
-		sdconn:                              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicediscovery"])})),
-		secretsmanagerconn:                  secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["secretsmanager"])})),
-		securityhubconn:                     securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["securityhub"])})),
-		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])})),
-		servicequotasconn:                   servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicequotas"])})),
-		sesConn:                             ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])})),
-		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
-		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
-		snsconn:                             sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sns"])})),
-		sqsconn:                             sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sqs"])})),
-		ssmconn:                             ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssm"])})),
-		storagegatewayconn:                  storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["storagegateway"])})),
-		stsconn:                             sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})),
-		swfconn:                             swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["swf"])})),
-		transferconn:                        transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["transfer"])})),
-		wafconn:                             waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["waf"])})),
-		wafregionalconn:                     wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafregional"])})),
-		worklinkconn:                        worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["worklink"])})),
-		workspacesconn:                      workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workspaces"])})),
-		xrayconn:                            xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["xray"])})),
+		resourcegroupsconn:                  resourcegroups.New(serviceSession("resourcegroups")),
+		route53resolverconn:                 route53resolver.New(serviceSession("route53resolver")),
+		s3conn:                              s3.New(serviceSession("s3", &aws.Config{S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle)})),
+		s3controlconn:                       s3control.New(serviceSession("s3control")),
+		sagemakerconn:                       sagemaker.New(serviceSession("sagemaker")),
+		scconn:                              servicecatalog.New(serviceSession("servicecatalog")),
+		sdconn:                              servicediscovery.New(serviceSession("servicediscovery")),
+		secretsmanagerconn:                  secretsmanager.New(serviceSession("secretsmanager")),
+		securityhubconn:                     securityhub.New(serviceSession("securityhub")),
+		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(serviceSession("serverlessrepo")),
+		servicequotasconn:                   servicequotas.New(serviceSession("servicequotas")),
+		sesConn:                             ses.New(serviceSession("ses")),
+		sfnconn:                             sfn.New(serviceSession("stepfunctions")),
+		simpledbconn:                        simpledb.New(serviceSession("sdb")),
+		snsconn:                             sns.New(serviceSession("sns")),
+		sqsconn:                             sqs.New(serviceSession("sqs")),
+		ssmconn:                             ssm.New(serviceSession("ssm")),
+		storagegatewayconn:                  storagegateway.New(serviceSession("storagegateway")),
+		stsconn:                             sts.New(serviceSession("sts")),
+		swfconn:                             swf.New(serviceSession("swf")),
+		transferconn:                        transfer.New(serviceSession("transfer")),
+		wafconn:                             waf.New(serviceSession("waf")),
+		wafregionalconn:                     wafregional.New(serviceSession("wafregional")),
+		worklinkconn:                        worklink.New(serviceSession("worklink")),
+		workspacesconn:                      workspaces.New(serviceSession("workspaces")),
+		xrayconn:                            xray.New(serviceSession("xray")),
 	}
 
 	// "Global" services that require customizations
@@ -475,7 +538,7 @@ func (c *Config) Client() (interface{}, error) {
 
 	// Handle deprecated endpoint configurations
 	if c.Endpoints["kinesis_analytics"] != "" {
-		client.kinesisanalyticsconn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis_analytics"])}))
+		client.kinesisanalyticsconn = kinesisanalytics.New(serviceSession("kinesisanalytics", &aws.Config{Endpoint: aws.String(c.Endpoints["kinesis_analytics"])}))
 	}
 	if c.Endpoints["r53"] != "" {
 		route53Config.Endpoint = aws.String(c.Endpoints["r53"])
@@ -503,9 +566,9 @@ func (c *Config) Client() (interface{}, error) {
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.globalacceleratorconn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.r53conn = route53.New(sess.Copy(route53Config))
-	client.shieldconn = shield.New(sess.Copy(shieldConfig))
+	client.globalacceleratorconn = globalaccelerator.New(serviceSession("globalaccelerator", globalAcceleratorConfig))
+	client.r53conn = route53.New(serviceSession("route53", route53Config))
+	client.shieldconn = shield.New(serviceSession("shield", shieldConfig))
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
 	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
@@ -553,6 +616,8 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	})
 
+	client.regionalClients.clients[c.Region] = client
+
 	client.ec2conn.Handlers.Retry.PushBack(func(r *request.Request) {
 		if r.Operation.Name == "CreateVpnConnection" {
 			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
@@ -615,6 +680,61 @@ func (c *Config) Client() (interface{}, error) {
 	return client, nil
 }
 
+// awsClientCache is a lazily populated cache of clients for regions other
+// than the provider's, shared by all clients of a provider configuration.
+type awsClientCache struct {
+	mu      sync.Mutex
+	clients map[string]*AWSClient
+}
+
+// RegionalClient returns a client for the given region, for data sources
+// which can read from regions other than the provider's. The client is
+// created with the provider configuration on first use and then cached. An
+// empty region returns the provider's client.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.region {
+		return client, nil
+	}
+
+	if client.config == nil || client.regionalClients == nil {
+		return nil, fmt.Errorf("AWS client for region (%s) cannot be created: provider configuration unavailable", region)
+	}
+
+	cache := client.regionalClients
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if regionalClient, ok := cache.clients[region]; ok {
+		return regionalClient, nil
+	}
+
+	log.Printf("[INFO] Creating AWS client for region: %s", region)
+
+	config := *client.config
+	config.Region = region
+
+	// The credentials and account ID were validated for the provider's region.
+	// The account ID is not requested again, so the allowed and forbidden
+	// account IDs must not be checked against an empty one.
+	config.SkipCredsValidation = true
+	config.SkipRequestingAccountId = true
+	config.AllowedAccountIds = nil
+	config.ForbiddenAccountIds = nil
+
+	raw, err := config.Client()
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS client for region (%s): %s", region, err)
+	}
+
+	regionalClient := raw.(*AWSClient)
+	regionalClient.accountid = client.accountid
+	regionalClient.regionalClients = cache
+
+	cache.clients[region] = regionalClient
+
+	return regionalClient, nil
+}
+
 func hasEc2Classic(platforms []string) bool {
 	for _, p := range platforms {
 		if p == "EC2" {
@@ -651,3 +771,187 @@ func GetSupportedEC2Platforms(conn *ec2.EC2) ([]string, error) {
 
 	return platforms, nil
 }
+
+// httpClient returns an HTTP client configured as aws-sdk-go-base configures
+// the provider session's client. A custom CA bundle set by AWS_CA_BUNDLE is
+// added to its transport when a session is created with it.
+func (c *Config) httpClient() *http.Client {
+	client := cleanhttp.DefaultClient()
+
+	if c.Insecure {
+		transport := client.Transport.(*http.Transport)
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	}
+
+	return client
+}
+
+// installRequestHandlers adds the API request logging handler to a
+// session's handlers.
+func (c *Config) installRequestHandlers(handlers *request.Handlers) {
+	// AWS Go SDK debug logging is left disabled, including in aws-sdk-go-base,
+	// as it dumps raw HTTP requests and responses, including secrets and
+	// credential headers. Redacted records are logged instead.
+	apilogging.NewLogger(logging.IsDebugOrHigher(), c.SensitiveAttributeNames).InstallHandlers(handlers)
+}
+
+// AssumeRole contains the configuration of an IAM role to assume.
+type AssumeRole struct {
+	RoleARN         string
+	SessionName     string
+	ExternalID      string
+	Policy          string
+	PolicyARNs      []string
+	DurationSeconds int
+}
+
+// AssumeRoleWithWebIdentity contains the configuration of an IAM role to
+// assume with a web identity token, e.g. an OpenID Connect ID token issued
+// to a CI job.
+type AssumeRoleWithWebIdentity struct {
+	RoleARN              string
+	SessionName          string
+	WebIdentityTokenFile string
+}
+
+// assumeRoleCredentials returns credentials which assume the configured web
+// identity role and then each configured role in turn, along with their
+// current value. The web identity role, if any, replaces the base
+// credentials.
+func (c *Config) assumeRoleCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, credentials.Value, error) {
+	var creds *credentials.Credentials
+	var sess *session.Session
+
+	if w := c.AssumeRoleWithWebIdentity; w != nil {
+		log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q, WebIdentityTokenFile: %q)",
+			w.RoleARN, w.SessionName, w.WebIdentityTokenFile)
+
+		// AssumeRoleWithWebIdentity requests are not signed
+		var err error
+		sess, err = session.NewSession(&aws.Config{
+			Credentials: credentials.AnonymousCredentials,
+			HTTPClient:  c.httpClient(),
+			MaxRetries:  aws.Int(c.MaxRetries),
+			Region:      aws.String(c.Region),
+		})
+		if err != nil {
+			return nil, credentials.Value{}, fmt.Errorf("error creating web identity session: %s", err)
+		}
+
+		c.installRequestHandlers(&sess.Handlers)
+
+		stsconn := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+		creds = credentials.NewCredentials(stscreds.NewWebIdentityRoleProvider(stsconn, w.RoleARN, w.SessionName, w.WebIdentityTokenFile))
+	} else {
+		baseConfig := *awsbaseConfig
+		baseConfig.SkipCredsValidation = true
+
+		var err error
+		sess, err = awsbase.GetSession(&baseConfig)
+		if err != nil {
+			return nil, credentials.Value{}, err
+		}
+
+		c.installRequestHandlers(&sess.Handlers)
+
+		creds = sess.Config.Credentials
+	}
+
+	for _, assumeRole := range c.AssumeRoles {
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, PolicyARNs: %q, DurationSeconds: %d)",
+			assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID, assumeRole.Policy, assumeRole.PolicyARNs, assumeRole.DurationSeconds)
+
+		stsconn := sts.New(sess.Copy(&aws.Config{
+			Credentials: creds,
+			Endpoint:    aws.String(c.Endpoints["sts"]),
+		}))
+		creds = credentials.NewCredentials(&assumeRoleProvider{
+			client:     stsconn,
+			assumeRole: assumeRole,
+		})
+	}
+
+	value, err := creds.Get()
+	if err != nil {
+		return nil, credentials.Value{}, fmt.Errorf("error assuming IAM Role: %s", err)
+	}
+
+	log.Printf("[INFO] AWS Auth provider used: %q", value.ProviderName)
+
+	return creds, value, nil
+}
+
+// assumeRoleAccountID returns the account ID of the last assumed role.
+func (c *Config) assumeRoleAccountID() string {
+	roleARN := ""
+
+	if c.AssumeRoleWithWebIdentity != nil {
+		roleARN = c.AssumeRoleWithWebIdentity.RoleARN
+	}
+
+	if len(c.AssumeRoles) > 0 {
+		roleARN = c.AssumeRoles[len(c.AssumeRoles)-1].RoleARN
+	}
+
+	parsedARN, err := arn.Parse(roleARN)
+	if err != nil {
+		return ""
+	}
+
+	return parsedARN.AccountID
+}
+
+// assumeRoleProvider is a credentials provider which assumes an IAM role.
+// Unlike stscreds.AssumeRoleProvider it supports managed session policies.
+type assumeRoleProvider struct {
+	credentials.Expiry
+
+	client     *sts.STS
+	assumeRole *AssumeRole
+}
+
+func (p *assumeRoleProvider) Retrieve() (credentials.Value, error) {
+	sessionName := p.assumeRole.SessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+
+	input := &sts.AssumeRoleInput{
+		RoleArn:         aws.String(p.assumeRole.RoleARN),
+		RoleSessionName: aws.String(sessionName),
+	}
+
+	if p.assumeRole.DurationSeconds > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.assumeRole.DurationSeconds))
+	}
+
+	if p.assumeRole.ExternalID != "" {
+		input.ExternalId = aws.String(p.assumeRole.ExternalID)
+	}
+
+	if p.assumeRole.Policy != "" {
+		input.Policy = aws.String(p.assumeRole.Policy)
+	}
+
+	for _, policyARN := range p.assumeRole.PolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	output, err := p.client.AssumeRole(input)
+	if err != nil {
+		return credentials.Value{ProviderName: stscreds.ProviderName}, fmt.Errorf("error assuming IAM Role (%s): %s", p.assumeRole.RoleARN, err)
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), assumeRoleExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    stscreds.ProviderName,
+	}, nil
+}
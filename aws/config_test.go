@@ -1,11 +1,19 @@
 package aws
 
 import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mockaws"
 )
 
 func TestGetSupportedEC2Platforms(t *testing.T) {
@@ -40,6 +48,198 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfigClient_AssumeRoleChain(t *testing.T) {
+	server := mockaws.NewServer()
+	defer server.Close()
+
+	tokenFile, err := ioutil.TempFile("", "tf-aws-web-identity-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+
+	if _, err := tokenFile.WriteString("example-web-identity-token"); err != nil {
+		t.Fatal(err)
+	}
+	tokenFile.Close()
+
+	config := &Config{
+		Region: "us-west-2",
+		Endpoints: map[string]string{
+			"sts": server.Endpoint("sts", mockaws.ProtocolQuery),
+		},
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:              "arn:aws:iam::111111111111:role/ci",
+			SessionName:          "ci",
+			WebIdentityTokenFile: tokenFile.Name(),
+		},
+		AssumeRoles: []*AssumeRole{
+			{
+				RoleARN: "arn:aws:iam::222222222222:role/hub",
+			},
+			{
+				RoleARN:         "arn:aws:iam::333333333333:role/spoke",
+				SessionName:     "spoke",
+				DurationSeconds: 1800,
+				PolicyARNs:      []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+			},
+		},
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	server.On("sts", "AssumeRoleWithWebIdentity").
+		WhenParam("WebIdentityToken", "example-web-identity-token").
+		Respond(fmt.Sprintf(testAwsStsAssumeRoleResponse, "AssumeRoleWithWebIdentity", "ASIACIEXAMPLE"))
+	server.On("sts", "AssumeRole").
+		WhenParam("RoleArn", "arn:aws:iam::222222222222:role/hub").
+		Respond(fmt.Sprintf(testAwsStsAssumeRoleResponse, "AssumeRole", "ASIAHUBEXAMPLE"))
+	server.On("sts", "AssumeRole").
+		WhenParam("RoleArn", "arn:aws:iam::333333333333:role/spoke").
+		Respond(fmt.Sprintf(testAwsStsAssumeRoleResponse, "AssumeRole", "ASIASPOKEEXAMPLE"))
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	for _, operation := range []string{"AssumeRoleWithWebIdentity", "AssumeRole"} {
+		if !strings.Contains(logs.String(), fmt.Sprintf(`"service":"sts","operation":%q`, operation)) {
+			t.Errorf("expected API request record for %s to be logged", operation)
+		}
+	}
+
+	if got, want := client.(*AWSClient).accountid, "333333333333"; got != want {
+		t.Errorf("expected account ID %q, got %q", want, got)
+	}
+
+	for _, r := range server.Unmatched() {
+		t.Errorf("unexpected request: %s %s", r.Operation, r.Body)
+	}
+
+	requests := server.Requests("sts", "AssumeRole")
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 AssumeRole requests, got %d", len(requests))
+	}
+
+	hub, spoke := requests[0], requests[1]
+
+	if got := hub.Header.Get("Authorization"); !strings.Contains(got, "Credential=ASIACIEXAMPLE/") {
+		t.Errorf("expected hub role to be assumed with web identity credentials, got Authorization: %s", got)
+	}
+
+	if got := spoke.Header.Get("Authorization"); !strings.Contains(got, "Credential=ASIAHUBEXAMPLE/") {
+		t.Errorf("expected spoke role to be assumed with hub role credentials, got Authorization: %s", got)
+	}
+
+	if got := spoke.Params.Get("RoleSessionName"); got != "spoke" {
+		t.Errorf("expected RoleSessionName spoke, got %q", got)
+	}
+
+	if got := spoke.Params.Get("DurationSeconds"); got != "1800" {
+		t.Errorf("expected DurationSeconds 1800, got %q", got)
+	}
+
+	if got := spoke.Params.Get("PolicyArns.member.1.arn"); got != "arn:aws:iam::aws:policy/ReadOnlyAccess" {
+		t.Errorf("expected PolicyArns.member.1.arn arn:aws:iam::aws:policy/ReadOnlyAccess, got %q", got)
+	}
+}
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	m := newTestMockAws(t)
+	defer m.Close(t)
+
+	client := m.client
+
+	if got, err := client.RegionalClient(""); err != nil || got != client {
+		t.Fatalf("expected provider client for empty region, got: %p, %v", got, err)
+	}
+
+	if got, err := client.RegionalClient(client.region); err != nil || got != client {
+		t.Fatalf("expected provider client for provider region, got: %p, %v", got, err)
+	}
+
+	regionalClient, err := client.RegionalClient("eu-west-1")
+	if err != nil {
+		t.Fatalf("error creating regional client: %s", err)
+	}
+
+	if regionalClient.region != "eu-west-1" {
+		t.Errorf("expected region eu-west-1, got %q", regionalClient.region)
+	}
+
+	if regionalClient.accountid != client.accountid {
+		t.Errorf("expected account ID %q, got %q", client.accountid, regionalClient.accountid)
+	}
+
+	if got := aws.StringValue(regionalClient.ec2conn.Config.Region); got != "eu-west-1" {
+		t.Errorf("expected EC2 client region eu-west-1, got %q", got)
+	}
+
+	if got, err := client.RegionalClient("eu-west-1"); err != nil || got != regionalClient {
+		t.Errorf("expected cached regional client, got: %p, %v", got, err)
+	}
+
+	if got, err := regionalClient.RegionalClient(client.region); err != nil || got != client {
+		t.Errorf("expected provider client from regional client, got: %p, %v", got, err)
+	}
+
+	if _, err := client.RegionalClient("not-a-region"); err == nil {
+		t.Errorf("expected error for invalid region")
+	}
+
+	testCases := []struct {
+		name                string
+		allowedAccountIds   []string
+		forbiddenAccountIds []string
+	}{
+		{
+			name:              "allowed_account_ids",
+			allowedAccountIds: []string{testMockAwsAccountID},
+		},
+		{
+			name:                "forbidden_account_ids",
+			forbiddenAccountIds: []string{"111111111111"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newTestMockAws(t)
+			defer m.Close(t)
+
+			m.client.config.AllowedAccountIds = tc.allowedAccountIds
+			m.client.config.ForbiddenAccountIds = tc.forbiddenAccountIds
+
+			regionalClient, err := m.client.RegionalClient("eu-west-1")
+			if err != nil {
+				t.Fatalf("error creating regional client: %s", err)
+			}
+
+			if regionalClient.accountid != testMockAwsAccountID {
+				t.Errorf("expected account ID %q, got %q", testMockAwsAccountID, regionalClient.accountid)
+			}
+		})
+	}
+}
+
+const testAwsStsAssumeRoleResponse = `<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <%[1]sResult>
+    <Credentials>
+      <AccessKeyId>%[2]s</AccessKeyId>
+      <SecretAccessKey>example-secret-access-key</SecretAccessKey>
+      <SessionToken>example-session-token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </%[1]sResult>
+</%[1]sResponse>`
+
 var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
   <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
   <accountAttributeSet>
@@ -166,7 +166,7 @@ func dataSourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta in
 		return fmt.Errorf("error reading ACMPCA Certificate Authority %q tags: %s", certificateAuthorityArn, err)
 	}
 
-	if err := d.Set("tags", tagsToMapACMPCA(tags)); err != nil {
+	if err := d.Set("tags", tagsToMapACMPCA(tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -48,6 +48,7 @@ func dataSourceAwsAmi() *schema.Resource {
 					ValidateFunc: validation.NoZeroValues,
 				},
 			},
+			"region": dataSourceRegionSchema(),
 			// Computed values.
 			"architecture": {
 				Type:     schema.TypeString,
@@ -183,7 +184,11 @@ func dataSourceAwsAmi() *schema.Resource {
 
 // dataSourceAwsAmiDescriptionRead performs the AMI lookup.
 func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	client, err := meta.(*AWSClient).RegionalClient(d.Get("region").(string))
+	if err != nil {
+		return err
+	}
+	conn := client.ec2conn
 
 	params := &ec2.DescribeImagesInput{
 		Owners: expandStringList(d.Get("owners").([]interface{})),
@@ -239,11 +244,13 @@ func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 
-	return amiDescriptionAttributes(d, filteredImages[0])
+	d.Set("region", client.region)
+
+	return amiDescriptionAttributes(d, filteredImages[0], client.ignoreTagsConfig)
 }
 
 // populate the numerous fields that the image description returns.
-func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
+func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image, ignoreConfig *IgnoreTagsConfig) error {
 	// Simple attributes first
 	d.SetId(*image.ImageId)
 	d.Set("architecture", image.Architecture)
@@ -290,7 +297,7 @@ func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
 	if err := d.Set("state_reason", amiStateReason(image.StateReason)); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsToMap(image.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(image.Tags, ignoreConfig)); err != nil {
 		return err
 	}
 	return nil
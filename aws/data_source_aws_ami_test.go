@@ -143,6 +143,26 @@ func TestAccAWSAmiDataSource_localNameFilter(t *testing.T) {
 	})
 }
 
+func TestAccAWSAmiDataSource_Region(t *testing.T) {
+	dataSourceName := "data.aws_ami.nat_ami"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccMultipleRegionsPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsAmiDataSourceConfigRegion(testAccGetAlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAmiDataSourceID(dataSourceName),
+					resource.TestMatchResourceAttr(dataSourceName, "image_id", regexp.MustCompile("^ami-")),
+					resource.TestCheckResourceAttr(dataSourceName, "owner_id", "137112412989"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", testAccGetAlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsAmiDataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -243,3 +263,18 @@ data "aws_ami" "name_regex_filtered_ami" {
   name_regex = "^amzn-ami-\\d{3}[5].*-ecs-optimized"
 }
 `
+
+func testAccCheckAwsAmiDataSourceConfigRegion(region string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "nat_ami" {
+  most_recent = true
+  owners      = ["amazon"]
+  region      = %[1]q
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-vpc-nat*"]
+  }
+}
+`, region)
+}
@@ -24,6 +24,8 @@ func dataSourceAwsCallerIdentity() *schema.Resource {
 				Computed: true,
 			},
 
+			"region": dataSourceRegionSchema(),
+
 			"user_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -33,10 +35,14 @@ func dataSourceAwsCallerIdentity() *schema.Resource {
 }
 
 func dataSourceAwsCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).stsconn
+	client, err := meta.(*AWSClient).RegionalClient(d.Get("region").(string))
+	if err != nil {
+		return err
+	}
+	conn := client.stsconn
 
 	log.Printf("[DEBUG] Reading Caller Identity")
-	res, err := client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	res, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
 
 	if err != nil {
 		return fmt.Errorf("Error getting Caller Identity: %v", err)
@@ -47,6 +53,7 @@ func dataSourceAwsCallerIdentityRead(d *schema.ResourceData, meta interface{}) e
 	d.SetId(time.Now().UTC().String())
 	d.Set("account_id", res.Account)
 	d.Set("arn", res.Arn)
+	d.Set("region", client.region)
 	d.Set("user_id", res.UserId)
 
 	return nil
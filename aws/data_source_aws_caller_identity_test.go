@@ -40,6 +40,24 @@ func TestAccAWSCallerIdentity_basic_panic(t *testing.T) {
 	})
 }
 
+func TestAccAWSCallerIdentity_Region(t *testing.T) {
+	dataSourceName := "data.aws_caller_identity.current"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccMultipleRegionsPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsCallerIdentityConfig_region(testAccGetAlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCallerIdentityAccountId(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "region", testAccGetAlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsCallerIdentityAccountId(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -80,3 +98,11 @@ provider "aws" {
 
 data "aws_caller_identity" "current" {}
 `
+
+func testAccCheckAwsCallerIdentityConfig_region(region string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {
+  region = %[1]q
+}
+`, region)
+}
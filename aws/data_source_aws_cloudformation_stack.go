@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsCloudFormationStack() *schema.Resource {
@@ -98,7 +99,7 @@ func dataSourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("parameters", flattenAllCloudFormationParameters(stack.Parameters))
-	d.Set("tags", flattenCloudFormationTags(stack.Tags))
+	d.Set("tags", keyvaluetags.CloudformationKeyValueTags(stack.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig).Map())
 	d.Set("outputs", flattenCloudFormationOutputs(stack.Outputs))
 
 	if len(stack.Capabilities) > 0 {
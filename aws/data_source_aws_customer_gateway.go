@@ -83,7 +83,7 @@ func dataSourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{})
 		d.Set("bgp_asn", int(asn))
 	}
 
-	if err := d.Set("tags", tagsToMap(cg.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(cg.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags for EC2 Customer Gateway %q: %s", aws.StringValue(cg.CustomerGatewayId), err)
 	}
 
@@ -178,6 +178,10 @@ func dataSourceAwsDynamoDbTable() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"kms_key_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -230,7 +234,7 @@ func dataSourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error setting ttl: %s", err)
 	}
 
-	tags, err := readDynamoDbTableTags(d.Get("arn").(string), conn)
+	tags, err := readDynamoDbTableTags(d.Get("arn").(string), conn, meta.(*AWSClient).ignoreTagsConfig)
 	if err != nil {
 		return err
 	}
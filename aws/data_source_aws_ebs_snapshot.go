@@ -134,10 +134,10 @@ func dataSourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	//Single Snapshot found so set to state
-	return snapshotDescriptionAttributes(d, resp.Snapshots[0])
+	return snapshotDescriptionAttributes(d, resp.Snapshots[0], meta.(*AWSClient).ignoreTagsConfig)
 }
 
-func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapshot) error {
+func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapshot, ignoreConfig *IgnoreTagsConfig) error {
 	d.SetId(*snapshot.SnapshotId)
 	d.Set("snapshot_id", snapshot.SnapshotId)
 	d.Set("volume_id", snapshot.VolumeId)
@@ -150,6 +150,6 @@ func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapsho
 	d.Set("owner_id", snapshot.OwnerId)
 	d.Set("owner_alias", snapshot.OwnerAlias)
 
-	err := d.Set("tags", tagsToMap(snapshot.Tags))
+	err := d.Set("tags", tagsToMap(snapshot.Tags, ignoreConfig))
 	return err
 }
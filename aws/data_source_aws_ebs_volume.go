@@ -141,6 +141,6 @@ func volumeDescriptionAttributes(d *schema.ResourceData, client *AWSClient, volu
 	d.Set("snapshot_id", volume.SnapshotId)
 	d.Set("volume_type", volume.VolumeType)
 
-	err := d.Set("tags", tagsToMap(volume.Tags))
+	err := d.Set("tags", tagsToMap(volume.Tags, client.ignoreTagsConfig))
 	return err
 }
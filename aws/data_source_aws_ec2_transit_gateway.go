@@ -118,7 +118,7 @@ func dataSourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{}
 	d.Set("owner_id", transitGateway.OwnerId)
 	d.Set("propagation_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
 
-	if err := d.Set("tags", tagsToMap(transitGateway.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(transitGateway.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
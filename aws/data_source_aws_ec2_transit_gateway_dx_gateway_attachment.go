@@ -65,7 +65,7 @@ func dataSourceAwsEc2TransitGatewayDxGatewayAttachmentRead(d *schema.ResourceDat
 
 	transitGatewayAttachment := output.TransitGatewayAttachments[0]
 
-	if err := d.Set("tags", tagsToMap(transitGatewayAttachment.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(transitGatewayAttachment.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -74,7 +74,7 @@ func dataSourceAwsEc2TransitGatewayRouteTableRead(d *schema.ResourceData, meta i
 	d.Set("default_association_route_table", aws.BoolValue(transitGatewayRouteTable.DefaultAssociationRouteTable))
 	d.Set("default_propagation_route_table", aws.BoolValue(transitGatewayRouteTable.DefaultPropagationRouteTable))
 
-	if err := d.Set("tags", tagsToMap(transitGatewayRouteTable.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(transitGatewayRouteTable.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -63,9 +63,17 @@ func dataSourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", repository.RepositoryName)
 	d.Set("repository_url", repository.RepositoryUri)
 
-	if err := getTagsECR(conn, d); err != nil {
+	tagsResp, err := conn.ListTagsForResource(&ecr.ListTagsForResourceInput{
+		ResourceArn: repository.RepositoryArn,
+	})
+
+	if err != nil {
 		return fmt.Errorf("error getting ECR repository tags: %s", err)
 	}
 
+	if err := d.Set("tags", tagsToMapECR(tagsResp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
@@ -145,7 +145,7 @@ func dataSourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	d.Set("public_ipv4_pool", eip.PublicIpv4Pool)
-	d.Set("tags", tagsToMap(eip.Tags))
+	d.Set("tags", tagsToMap(eip.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -208,5 +208,20 @@ func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(*resp.LoadBalancerDescriptions[0].LoadBalancerName)
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, resp.LoadBalancerDescriptions[0])
+	lb := resp.LoadBalancerDescriptions[0]
+
+	if err := flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, lb); err != nil {
+		return err
+	}
+
+	tags, err := describeAwsELbTags(elbconn, lb.LoadBalancerName, meta.(*AWSClient).ignoreTagsConfig)
+	if err != nil {
+		return fmt.Errorf("error describing tags for ELB (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
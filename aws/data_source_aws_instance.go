@@ -324,7 +324,7 @@ func dataSourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] aws_instance - Single Instance ID found: %s", *instance.InstanceId)
-	if err := instanceDescriptionAttributes(d, instance, conn); err != nil {
+	if err := instanceDescriptionAttributes(d, instance, conn, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -350,7 +350,7 @@ func dataSourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 // Populate instance attribute fields with the returned instance
-func instanceDescriptionAttributes(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
+func instanceDescriptionAttributes(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2, ignoreConfig *IgnoreTagsConfig) error {
 	d.SetId(*instance.InstanceId)
 	// Set the easy attributes
 	d.Set("instance_state", instance.State.Name)
@@ -399,7 +399,7 @@ func instanceDescriptionAttributes(d *schema.ResourceData, instance *ec2.Instanc
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	d.Set("tags", tagsToMap(instance.Tags, ignoreConfig))
 
 	// Security Groups
 	if err := readSecurityGroups(d, instance, conn); err != nil {
@@ -80,7 +80,7 @@ func dataSourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{})
 
 	igw := resp.InternetGateways[0]
 	d.SetId(aws.StringValue(igw.InternetGatewayId))
-	d.Set("tags", tagsToMap(igw.Tags))
+	d.Set("tags", tagsToMap(igw.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	d.Set("owner_id", igw.OwnerId)
 	d.Set("internet_gateway_id", igw.InternetGatewayId)
 
@@ -379,7 +379,7 @@ func dataSourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("name", lt.LaunchTemplateName)
 	d.Set("latest_version", lt.LatestVersionNumber)
 	d.Set("default_version", lt.DefaultVersionNumber)
-	d.Set("tags", tagsToMap(lt.Tags))
+	d.Set("tags", tagsToMap(lt.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
@@ -146,5 +146,20 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(aws.StringValue(describeResp.LoadBalancers[0].LoadBalancerArn))
 
-	return flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0])
+	lb := describeResp.LoadBalancers[0]
+
+	if err := flattenAwsLbResource(d, meta, lb); err != nil {
+		return err
+	}
+
+	tags, err := describeAwsLbTags(elbconn, lb.LoadBalancerArn, meta.(*AWSClient).ignoreTagsConfig)
+	if err != nil {
+		return fmt.Errorf("Error retrieving LB Tags: %s", err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
@@ -176,5 +176,19 @@ func dataSourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) er
 	targetGroup := describeResp.TargetGroups[0]
 
 	d.SetId(aws.StringValue(targetGroup.TargetGroupArn))
-	return flattenAwsLbTargetGroupResource(d, meta, targetGroup)
+
+	if err := flattenAwsLbTargetGroupResource(d, meta, targetGroup); err != nil {
+		return err
+	}
+
+	tags, err := describeAwsLbTags(elbconn, targetGroup.TargetGroupArn, meta.(*AWSClient).ignoreTagsConfig)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Target Group Tags: %s", err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
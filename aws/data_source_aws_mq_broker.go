@@ -176,10 +176,11 @@ func dataSourceAwsMqBroker() *schema.Resource {
 }
 
 func dataSourceAwsmQBrokerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mqconn
+
 	if brokerId, ok := d.GetOk("broker_id"); ok {
 		d.SetId(brokerId.(string))
 	} else {
-		conn := meta.(*AWSClient).mqconn
 		brokerName := d.Get("broker_name").(string)
 		var nextToken string
 		for {
@@ -205,5 +206,25 @@ func dataSourceAwsmQBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return resourceAwsMqBrokerRead(d, meta)
+	out, err := readAwsMqBroker(d, conn)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	tagsResp, err := conn.ListTags(&mq.ListTagsInput{
+		ResourceArn: out.BrokerArn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for MQ Broker (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tagsResp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
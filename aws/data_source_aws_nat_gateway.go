@@ -122,7 +122,7 @@ func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("state", ngw.State)
 	d.Set("subnet_id", ngw.SubnetId)
 	d.Set("vpc_id", ngw.VpcId)
-	d.Set("tags", tagsToMap(ngw.Tags))
+	d.Set("tags", tagsToMap(ngw.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	for _, address := range ngw.NatGatewayAddresses {
 		if *address.AllocationId != "" {
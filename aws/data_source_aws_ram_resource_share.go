@@ -108,7 +108,7 @@ func dataSourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{})
 				d.Set("owning_account_id", aws.StringValue(r.OwningAccountId))
 				d.Set("status", aws.StringValue(r.Status))
 
-				if err := d.Set("tags", tagsToMapRAM(r.Tags)); err != nil {
+				if err := d.Set("tags", tagsToMapRAM(r.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 					return fmt.Errorf("error setting tags: %s", err)
 				}
 
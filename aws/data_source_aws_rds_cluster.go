@@ -264,8 +264,14 @@ func dataSourceAwsRdsClusterRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Fetch and save tags
-	if err := saveTagsRDS(conn, d, aws.StringValue(dbc.DBClusterArn)); err != nil {
+	tagsResp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
+		ResourceName: dbc.DBClusterArn,
+	})
+
+	if err != nil {
 		log.Printf("[WARN] Failed to save tags for RDS Cluster (%s): %s", aws.StringValue(dbc.DBClusterIdentifier), err)
+	} else if err := d.Set("tags", tagsToMapRDS(tagsResp.TagList, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
@@ -23,6 +23,7 @@ func dataSourceAwsSsmParameter() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"region": dataSourceRegionSchema(),
 			"type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -46,7 +47,11 @@ func dataSourceAwsSsmParameter() *schema.Resource {
 }
 
 func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	client, err := meta.(*AWSClient).RegionalClient(d.Get("region").(string))
+	if err != nil {
+		return err
+	}
+	ssmconn := client.ssmconn
 
 	name := d.Get("name").(string)
 
@@ -66,14 +71,15 @@ func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(*param.Name)
 
 	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
+		Partition: client.partition,
+		Region:    client.region,
 		Service:   "ssm",
-		AccountID: meta.(*AWSClient).accountid,
+		AccountID: client.accountid,
 		Resource:  fmt.Sprintf("parameter/%s", strings.TrimPrefix(d.Id(), "/")),
 	}
 	d.Set("arn", arn.String())
 	d.Set("name", param.Name)
+	d.Set("region", client.region)
 	d.Set("type", param.Type)
 	d.Set("value", param.Value)
 	d.Set("version", param.Version)
@@ -3,9 +3,11 @@ package aws
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestAccAWSSsmParameterDataSource_basic(t *testing.T) {
@@ -70,6 +72,39 @@ func TestAccAWSSsmParameterDataSource_fullPath(t *testing.T) {
 	})
 }
 
+func TestDataSourceAwsSsmParameterRead_region(t *testing.T) {
+	m := newTestMockAws(t, "ssm")
+	defer m.Close(t)
+
+	m.On("ssm", "GetParameter").Respond(`{"Parameter":{"Name":"/example","Type":"String","Value":"example-value","Version":1}}`)
+
+	d := schema.TestResourceDataRaw(t, dataSourceAwsSsmParameter().Schema, map[string]interface{}{
+		"name":   "/example",
+		"region": "eu-west-1",
+	})
+
+	if err := dataAwsSsmParameterRead(d, m.client); err != nil {
+		t.Fatalf("error reading SSM Parameter: %s", err)
+	}
+
+	if got, want := d.Get("arn").(string), "arn:aws:ssm:eu-west-1:123456789012:parameter/example"; got != want {
+		t.Errorf("expected ARN %q, got %q", want, got)
+	}
+
+	if got := d.Get("region").(string); got != "eu-west-1" {
+		t.Errorf("expected region eu-west-1, got %q", got)
+	}
+
+	requests := m.Requests("ssm", "GetParameter")
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 GetParameter request, got %d", len(requests))
+	}
+
+	if got := requests[0].Header.Get("Authorization"); !strings.Contains(got, "/eu-west-1/ssm/") {
+		t.Errorf("expected request to be signed for eu-west-1, got Authorization: %s", got)
+	}
+}
+
 func testAccCheckAwsSsmParameterDataSourceConfig(name string, withDecryption string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
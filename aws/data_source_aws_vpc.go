@@ -97,6 +97,8 @@ func dataSourceAwsVpc() *schema.Resource {
 				Computed: true,
 			},
 
+			"region": dataSourceRegionSchema(),
+
 			"state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -114,7 +116,11 @@ func dataSourceAwsVpc() *schema.Resource {
 }
 
 func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	client, err := meta.(*AWSClient).RegionalClient(d.Get("region").(string))
+	if err != nil {
+		return err
+	}
+	conn := client.ec2conn
 
 	req := &ec2.DescribeVpcsInput{}
 
@@ -176,14 +182,15 @@ func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("instance_tenancy", vpc.InstanceTenancy)
 	d.Set("default", vpc.IsDefault)
 	d.Set("state", vpc.State)
-	d.Set("tags", tagsToMap(vpc.Tags))
+	d.Set("region", client.region)
+	d.Set("tags", tagsToMap(vpc.Tags, client.ignoreTagsConfig))
 	d.Set("owner_id", vpc.OwnerId)
 
 	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
+		Partition: client.partition,
 		Service:   "ec2",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
+		Region:    client.region,
+		AccountID: client.accountid,
 		Resource:  fmt.Sprintf("vpc/%s", d.Id()),
 	}.String()
 	d.Set("arn", arn)
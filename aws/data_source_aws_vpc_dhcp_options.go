@@ -122,7 +122,7 @@ func dataSourceAwsVpcDhcpOptionsRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if err := d.Set("tags", d.Set("tags", tagsToMap(output.DhcpOptions[0].Tags))); err != nil {
+	if err := d.Set("tags", d.Set("tags", tagsToMap(output.DhcpOptions[0].Tags, meta.(*AWSClient).ignoreTagsConfig))); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 	d.Set("owner_id", output.DhcpOptions[0].OwnerId)
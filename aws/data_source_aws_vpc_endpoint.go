@@ -200,7 +200,7 @@ func dataSourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return fmt.Errorf("error setting subnet_ids: %s", err)
 	}
-	err = d.Set("tags", tagsToMap(vpce.Tags))
+	err = d.Set("tags", tagsToMap(vpce.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	if err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
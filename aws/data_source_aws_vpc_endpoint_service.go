@@ -137,7 +137,7 @@ func dataSourceAwsVpcEndpointServiceRead(d *schema.ResourceData, meta interface{
 	d.Set("private_dns_name", sd.PrivateDnsName)
 	d.Set("service_id", sd.ServiceId)
 	d.Set("service_type", sd.ServiceType[0].ServiceType)
-	err = d.Set("tags", tagsToMap(sd.Tags))
+	err = d.Set("tags", tagsToMap(sd.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	if err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
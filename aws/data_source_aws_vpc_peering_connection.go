@@ -137,7 +137,7 @@ func dataSourceAwsVpcPeeringConnectionRead(d *schema.ResourceData, meta interfac
 	d.Set("peer_owner_id", pcx.AccepterVpcInfo.OwnerId)
 	d.Set("peer_cidr_block", pcx.AccepterVpcInfo.CidrBlock)
 	d.Set("peer_region", pcx.AccepterVpcInfo.Region)
-	d.Set("tags", tagsToMap(pcx.Tags))
+	d.Set("tags", tagsToMap(pcx.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	if pcx.AccepterVpcInfo.PeeringOptions != nil {
 		if err := d.Set("accepter", flattenVpcPeeringConnectionOptions(pcx.AccepterVpcInfo.PeeringOptions)[0]); err != nil {
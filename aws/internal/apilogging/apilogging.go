@@ -0,0 +1,351 @@
+// Package apilogging provides structured logging of AWS API requests.
+// A single record is logged for each API operation call, after any retries,
+// with the service, operation, duration, retry count, request ID and error
+// code. When request bodies are logged, the operation input and output are
+// included with the values of sensitive members and headers redacted, so
+// debug logs do not contain passwords, secrets, key material or credentials.
+package apilogging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// RedactedValue replaces the value of sensitive members and headers.
+	RedactedValue = "[REDACTED]"
+
+	// CompleteHandlerName is the name of the request handler that logs
+	// records, for use with the AWS Go SDK named handler lists.
+	CompleteHandlerName = "terraform-provider-aws.apilogging.Complete"
+)
+
+// KnownSensitiveMembers are the names of AWS API members known to carry
+// secrets in every service. They are always redacted, in addition to the
+// members tagged sensitive in the AWS Go SDK.
+var KnownSensitiveMembers = []string{
+	"AuthKey",
+	"AuthToken",
+	"ClientSecret",
+	"KeyMaterial",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Passphrase",
+	"Password",
+	"Plaintext",
+	"PreSharedKey",
+	"PrivateKey",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+	"SSECustomerKey",
+}
+
+// KnownSensitiveServiceMembers are the members of AWS API shapes known to
+// carry secrets in a single service, keyed by service name, which are not
+// tagged sensitive in the AWS Go SDK. Members are named by their shape and
+// member name, e.g. Parameter.Value, as names such as Value or Key alone
+// also name members which do not carry secrets, e.g. Tag.Value.
+var KnownSensitiveServiceMembers = map[string][]string{
+	"apigateway": {
+		"ApiKey.Value",
+		"CreateApiKeyInput.Value",
+	},
+	"appsync": {
+		"ApiKey.Id",
+	},
+	"codebuild": {
+		"SourceAuth.Resource",
+	},
+	"ec2": {
+		"GetPasswordDataOutput.PasswordData",
+	},
+	"glue": {
+		"Connection.ConnectionProperties",
+		"ConnectionInput.ConnectionProperties",
+	},
+	"pinpoint": {
+		"APNSChannelRequest.Certificate",
+		"APNSChannelRequest.TokenKey",
+		"APNSSandboxChannelRequest.Certificate",
+		"APNSSandboxChannelRequest.TokenKey",
+		"APNSVoipChannelRequest.Certificate",
+		"APNSVoipChannelRequest.TokenKey",
+		"APNSVoipSandboxChannelRequest.Certificate",
+		"APNSVoipSandboxChannelRequest.TokenKey",
+		"BaiduChannelRequest.ApiKey",
+		"BaiduChannelRequest.SecretKey",
+		"GCMChannelRequest.ApiKey",
+	},
+	"ssm": {
+		"Parameter.Value",
+		"ParameterHistory.Value",
+		"PutParameterInput.Value",
+	},
+}
+
+// SensitiveHeaders are the canonical names of HTTP request headers whose
+// values are always redacted.
+var SensitiveHeaders = []string{
+	"Authorization",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+}
+
+// Record is the structured log record of an API operation call.
+type Record struct {
+	Service    string            `json:"service"`
+	Operation  string            `json:"operation"`
+	Duration   string            `json:"duration"`
+	RetryCount int               `json:"retry_count"`
+	RequestID  string            `json:"request_id,omitempty"`
+	StatusCode int               `json:"status_code,omitempty"`
+	ErrorCode  string            `json:"error_code,omitempty"`
+	Header     map[string]string `json:"header,omitempty"`
+	Input      interface{}       `json:"input,omitempty"`
+	Output     interface{}       `json:"output,omitempty"`
+}
+
+// Logger logs a Record for each API operation call.
+type Logger struct {
+	logBodies           bool
+	sensitiveMembers    map[string]bool
+	sensitiveAttributes map[string]bool
+	sensitiveHeaders    map[string]bool
+}
+
+// NewLogger creates a Logger. If logBodies is set, records include the
+// request headers and the operation input and output.
+//
+// sensitiveAttributeNames are the names of Terraform attributes marked
+// Sensitive, which are redacted from members with the same name, ignoring
+// case and underscores, e.g. kdc_admin_password redacts the EMR member
+// KdcAdminPassword. Single word names, e.g. value or key, and
+// identifiers, e.g. client_id, also name members which do not carry secrets
+// in other shapes, e.g. Tag.Value, so they are not matched; secret members
+// with such names are listed in KnownSensitiveServiceMembers instead.
+func NewLogger(logBodies bool, sensitiveAttributeNames []string) *Logger {
+	l := &Logger{
+		logBodies:           logBodies,
+		sensitiveMembers:    make(map[string]bool),
+		sensitiveAttributes: make(map[string]bool),
+		sensitiveHeaders:    make(map[string]bool),
+	}
+
+	for _, name := range KnownSensitiveMembers {
+		l.sensitiveMembers[name] = true
+	}
+
+	for service, names := range KnownSensitiveServiceMembers {
+		for _, name := range names {
+			l.sensitiveMembers[service+"/"+name] = true
+		}
+	}
+
+	for _, name := range sensitiveAttributeNames {
+		if !strings.Contains(name, "_") || strings.HasSuffix(name, "_id") {
+			continue
+		}
+
+		l.sensitiveAttributes[attributeMemberName(name)] = true
+	}
+
+	for _, name := range SensitiveHeaders {
+		l.sensitiveHeaders[http.CanonicalHeaderKey(name)] = true
+	}
+
+	return l
+}
+
+// InstallHandlers adds a request handler which logs a record for each API
+// operation call made by clients created with the given handlers.
+func (l *Logger) InstallHandlers(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{Name: CompleteHandlerName, Fn: l.complete})
+}
+
+// Record returns the record of a completed request.
+func (l *Logger) Record(r *request.Request) *Record {
+	record := &Record{
+		Service:    r.ClientInfo.ServiceName,
+		Duration:   time.Since(r.Time).String(),
+		RetryCount: r.RetryCount,
+		RequestID:  r.RequestID,
+	}
+
+	if r.Operation != nil {
+		record.Operation = r.Operation.Name
+	}
+
+	if r.HTTPResponse != nil {
+		record.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if awsErr, ok := r.Error.(awserr.Error); ok {
+		record.ErrorCode = awsErr.Code()
+	}
+
+	if !l.logBodies {
+		return record
+	}
+
+	if r.HTTPRequest != nil && len(r.HTTPRequest.Header) > 0 {
+		record.Header = make(map[string]string, len(r.HTTPRequest.Header))
+
+		for k, v := range r.HTTPRequest.Header {
+			if l.sensitiveHeaders[http.CanonicalHeaderKey(k)] {
+				record.Header[k] = RedactedValue
+				continue
+			}
+
+			record.Header[k] = strings.Join(v, ", ")
+		}
+	}
+
+	record.Input = l.Redact(record.Service, r.Params)
+
+	if r.Error == nil {
+		record.Output = l.Redact(record.Service, r.Data)
+	}
+
+	return record
+}
+
+// Redact returns a copy of an API operation input or output of a service as
+// generic maps, slices and values, suitable for JSON encoding, with sensitive
+// members redacted. Byte slices and streams are replaced by a placeholder.
+func (l *Logger) Redact(service string, v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	return l.redact(service, reflect.ValueOf(v))
+}
+
+func (l *Logger) redact(service string, v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		if _, ok := v.Interface().(io.Reader); ok {
+			return "[stream]"
+		}
+
+		return l.redact(service, v.Elem())
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t.Format(time.RFC3339)
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			value := v.Field(i)
+
+			// Shapes tagged sensitive in the SDK are redacted as a whole
+			if field.Name == "_" && isSensitiveField(field) {
+				return RedactedValue
+			}
+
+			// Skip unexported fields, e.g. the SDK's metadata fields
+			if field.PkgPath != "" || isNil(value) {
+				continue
+			}
+
+			if isSensitiveField(field) || l.isSensitiveMember(service, v.Type().Name(), field.Name) {
+				m[field.Name] = RedactedValue
+				continue
+			}
+
+			m[field.Name] = l.redact(service, value)
+		}
+
+		return m
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("[%d bytes]", v.Len())
+		}
+
+		fallthrough
+	case reflect.Array:
+		s := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			s[i] = l.redact(service, v.Index(i))
+		}
+
+		return s
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		m := make(map[string]interface{}, v.Len())
+
+		for _, key := range v.MapKeys() {
+			m[fmt.Sprint(key.Interface())] = l.redact(service, v.MapIndex(key))
+		}
+
+		return m
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer, reflect.Invalid:
+		return nil
+	}
+
+	return v.Interface()
+}
+
+// isSensitiveMember returns whether a member of a shape of a service is
+// known to carry secrets.
+func (l *Logger) isSensitiveMember(service, shape, member string) bool {
+	return l.sensitiveMembers[member] || l.sensitiveMembers[service+"/"+shape+"."+member] || l.sensitiveAttributes[strings.ToLower(member)]
+}
+
+func (l *Logger) complete(r *request.Request) {
+	b, err := json.Marshal(l.Record(r))
+
+	if err != nil {
+		log.Printf("[WARN] Error encoding AWS API request log record for %s/%s: %s", r.ClientInfo.ServiceName, r.Operation.Name, err)
+		return
+	}
+
+	log.Printf("[DEBUG] AWS API request: %s", b)
+}
+
+// isSensitiveField returns whether a field of an AWS Go SDK shape is tagged
+// sensitive in the API model.
+func isSensitiveField(field reflect.StructField) bool {
+	return field.Tag.Get("sensitive") == "true"
+}
+
+// attributeMemberName returns the lower case API member name matching a
+// Terraform attribute name, e.g. master_password becomes masterpassword.
+func attributeMemberName(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return v.IsNil()
+	}
+
+	return false
+}
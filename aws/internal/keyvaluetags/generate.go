@@ -0,0 +1,4 @@
+//go:generate go run -tags generate ./generators/servicetags/main.go
+//go:generate go run -tags generate ./generators/updatetags/main.go
+
+package keyvaluetags
@@ -0,0 +1,266 @@
+// Package keyvaluetags provides a service independent representation of
+// resource tags along with generated conversion and update functions for each
+// AWS Go SDK service which supports tagging.
+package keyvaluetags
+
+import (
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+const (
+	// AwsTagKeyPrefix is the prefix reserved by AWS for system tags, which
+	// cannot be managed by users.
+	AwsTagKeyPrefix = `aws:`
+)
+
+// IgnoreConfig contains the provider-level configuration for tags that
+// should be ignored across all resources, in addition to the AWS reserved
+// "aws:" prefix. Ignored tags are not read into the Terraform state, so they
+// are never removed when updating a resource's tags.
+type IgnoreConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// Ignored returns true if the tag key matches the configured keys or key prefixes.
+// A nil configuration ignores nothing.
+func (c *IgnoreConfig) Ignored(key string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, k := range c.Keys {
+		if key == k {
+			log.Printf("[DEBUG] Found tag %s matching configured ignore_tags keys, ignoring.", key)
+			return true
+		}
+	}
+
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			log.Printf("[DEBUG] Found tag %s matching configured ignore_tags key_prefixes, ignoring.", key)
+			return true
+		}
+	}
+
+	return false
+}
+
+// KeyValueTags is a standard implementation for AWS key-value resource tags.
+// The AWS Go SDK is split into multiple service packages, each with its own
+// Go struct type representing a resource tag. To standardize logic across all
+// these Go types, we convert them into this Go type.
+type KeyValueTags map[string]*string
+
+// IgnoreAws returns non-AWS tag keys.
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !strings.HasPrefix(k, AwsTagKeyPrefix) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// IgnoreConfig returns any tags not removed by the given configuration.
+func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !config.Ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Ignore returns non-matching tag keys.
+func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := ignoreTags[k]; ok {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnorePrefixes returns non-matching tag key prefixes.
+func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagPrefix := range ignoreTagPrefixes {
+			if strings.HasPrefix(k, ignoreTagPrefix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// Keys returns the sorted tag keys.
+func (tags KeyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// Map returns tag keys mapped to their values.
+func (tags KeyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		result[k] = aws.StringValue(v)
+	}
+
+	return result
+}
+
+// Merge adds missing and updates existing tags.
+func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range mergeTags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns tags removed.
+func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns tags added and updated.
+func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || aws.StringValue(oldV) != aws.StringValue(newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// Chunks returns the tags split into groups of at most the given size, for
+// services limiting the number of tags in a single tagging request. The
+// tags are split in sorted key order.
+func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
+	var result []KeyValueTags
+
+	if size < 1 {
+		return result
+	}
+
+	var chunk KeyValueTags
+
+	for _, k := range tags.Keys() {
+		if chunk == nil || len(chunk) == size {
+			chunk = make(KeyValueTags, size)
+			result = append(result, chunk)
+		}
+
+		chunk[k] = tags[k]
+	}
+
+	return result
+}
+
+// New creates KeyValueTags from common Terraform Provider SDK types.
+// Supports KeyValueTags, map[string]string, map[string]*string,
+// map[string]interface{}, []string and []interface{}. When passed []string or
+// []interface{}, all elements are treated as keys and assigned nil values.
+func New(i interface{}) KeyValueTags {
+	switch value := i.(type) {
+	case KeyValueTags:
+		return value.Merge(nil)
+	case map[string]string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v // Prevent referencing issues
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case map[string]*string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			kvtm[k] = v
+		}
+
+		return kvtm
+	case map[string]interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v.(string)
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case []string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v] = nil
+		}
+
+		return kvtm
+	case []interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v.(string)] = nil
+		}
+
+		return kvtm
+	default:
+		return make(KeyValueTags)
+	}
+}
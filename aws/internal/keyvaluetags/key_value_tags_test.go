@@ -0,0 +1,573 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"aws:cloudformation:key2": "value2",
+				"aws:cloudformation:key3": "value3",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"key2":                    "value2",
+				"key3":                    "value3",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreAws()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreConfig(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		ignoreConfig *IgnoreConfig
+		want         map[string]string
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			ignoreConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: []string{"key1", "key3"},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "key prefixes",
+			tags: New(map[string]string{
+				"config:key1": "value1",
+				"key2":        "value2",
+				"config:key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPrefixes: []string{"config:"},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreConfig(testCase.ignoreConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		ignoreTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			ignoreTags: New(map[string]string{}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "some",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTags: New([]string{"key1", "key3"}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Ignore(testCase.ignoreTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagPrefixes KeyValueTags
+		want              map[string]string
+	}{
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagPrefixes: New([]string{"other"}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "some",
+			tags: New(map[string]string{
+				"prefix1:key1": "value1",
+				"key2":         "value2",
+				"prefix2:key3": "value3",
+			}),
+			ignoreTagPrefixes: New([]string{"prefix1", "prefix2"}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnorePrefixes(testCase.ignoreTagPrefixes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: []string{},
+		},
+		{
+			name: "sorted",
+			tags: New(map[string]string{
+				"key3": "value3",
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: []string{"key1", "key2", "key3"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Keys()
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %#v, expected %#v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsMerge(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tags      KeyValueTags
+		mergeTags KeyValueTags
+		want      map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			mergeTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "add and update",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			mergeTags: New(map[string]string{
+				"key2": "value2updated",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2updated",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Merge(testCase.mergeTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRemoved(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all new",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "updated value is not removed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Removed(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsUpdated(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "added",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "updated",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Updated(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		size int
+		want []map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			size: 2,
+			want: nil,
+		},
+		{
+			name: "invalid size",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			size: 0,
+			want: nil,
+		},
+		{
+			name: "split",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			size: 2,
+			want: []map[string]string{
+				{
+					"key1": "value1",
+					"key2": "value2",
+				},
+				{
+					"key3": "value3",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Chunks(testCase.size)
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("got %d chunks, expected %d", len(got), len(testCase.want))
+			}
+
+			for i, chunk := range got {
+				testKeyValueTagsVerifyMap(t, chunk.Map(), testCase.want[i])
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source interface{}
+		want   map[string]string
+	}{
+		{
+			name:   "nil",
+			source: nil,
+			want:   map[string]string{},
+		},
+		{
+			name: "map_string_interface",
+			source: map[string]interface{}{
+				"key1": "value1",
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "map_string_string",
+			source: map[string]string{
+				"key1": "value1",
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "map_string_stringPointer",
+			source: map[string]*string{
+				"key1": aws.String("value1"),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:   "slice_interface",
+			source: []interface{}{"key1"},
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := New(testCase.source)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestEc2Tags(t *testing.T) {
+	tags := New(map[string]string{
+		"key2": "value2",
+		"key1": "value1",
+	})
+
+	want := []*ec2.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	if got := tags.Ec2Tags(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+
+	testKeyValueTagsVerifyMap(t, Ec2KeyValueTags(want).Map(), tags.Map())
+}
+
+func TestKmsTags(t *testing.T) {
+	tags := New(map[string]string{
+		"key1": "value1",
+	})
+
+	want := []*kms.Tag{
+		{
+			TagKey:   aws.String("key1"),
+			TagValue: aws.String("value1"),
+		},
+	}
+
+	if got := tags.KmsTags(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+
+	testKeyValueTagsVerifyMap(t, KmsKeyValueTags(want).Map(), tags.Map())
+}
+
+func TestApigatewayTags(t *testing.T) {
+	tags := New(map[string]string{
+		"key1": "value1",
+	})
+
+	want := map[string]*string{
+		"key1": aws.String("value1"),
+	}
+
+	if got := tags.ApigatewayTags(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s, expected %s", aws.StringValueMap(got), aws.StringValueMap(want))
+	}
+
+	testKeyValueTagsVerifyMap(t, ApigatewayKeyValueTags(want).Map(), tags.Map())
+}
+
+func testKeyValueTagsVerifyMap(t *testing.T, got map[string]string, want map[string]string) {
+	for k, wantV := range want {
+		gotV, ok := got[k]
+
+		if !ok {
+			t.Errorf("want missing key: %s", k)
+			continue
+		}
+
+		if gotV != wantV {
+			t.Errorf("got key (%s) value %s; want value %s", k, gotV, wantV)
+		}
+	}
+
+	for k := range got {
+		if _, ok := want[k]; !ok {
+			t.Errorf("got extra key: %s", k)
+		}
+	}
+}
@@ -0,0 +1,2904 @@
+// Code generated by generators/updatetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+)
+
+// AcmUpdateTags updates acm service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func AcmUpdateTags(conn *acm.ACM, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &acm.RemoveTagsFromCertificateInput{
+			CertificateArn: aws.String(identifier),
+			Tags:           removedTags.AcmTags(),
+		}
+
+		_, err := conn.RemoveTagsFromCertificate(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &acm.AddTagsToCertificateInput{
+			CertificateArn: aws.String(identifier),
+			Tags:           updatedTags.AcmTags(),
+		}
+
+		_, err := conn.AddTagsToCertificate(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// AcmpcaUpdateTags updates acmpca service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func AcmpcaUpdateTags(conn *acmpca.ACMPCA, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &acmpca.UntagCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(identifier),
+			Tags:                    removedTags.AcmpcaTags(),
+		}
+
+		_, err := conn.UntagCertificateAuthority(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &acmpca.TagCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(identifier),
+			Tags:                    updatedTags.AcmpcaTags(),
+		}
+
+		_, err := conn.TagCertificateAuthority(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ApigatewayUpdateTags updates apigateway service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func ApigatewayUpdateTags(conn *apigateway.APIGateway, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &apigateway.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &apigateway.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.ApigatewayTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// Apigatewayv2UpdateTags updates apigatewayv2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func Apigatewayv2UpdateTags(conn *apigatewayv2.ApiGatewayV2, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &apigatewayv2.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &apigatewayv2.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.Apigatewayv2Tags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// AppmeshUpdateTags updates appmesh service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func AppmeshUpdateTags(conn *appmesh.AppMesh, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &appmesh.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &appmesh.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.AppmeshTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// AppsyncUpdateTags updates appsync service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func AppsyncUpdateTags(conn *appsync.AppSync, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &appsync.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &appsync.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.AppsyncTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// AthenaUpdateTags updates athena service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func AthenaUpdateTags(conn *athena.Athena, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &athena.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &athena.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.AthenaTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// BackupUpdateTags updates backup service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func BackupUpdateTags(conn *backup.Backup, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &backup.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeyList:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &backup.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.BackupTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// Cloudhsmv2UpdateTags updates cloudhsmv2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func Cloudhsmv2UpdateTags(conn *cloudhsmv2.CloudHSMV2, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &cloudhsmv2.UntagResourceInput{
+			ResourceId: aws.String(identifier),
+			TagKeyList: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &cloudhsmv2.TagResourceInput{
+			ResourceId: aws.String(identifier),
+			TagList:    updatedTags.Cloudhsmv2Tags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CloudtrailUpdateTags updates cloudtrail service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CloudtrailUpdateTags(conn *cloudtrail.CloudTrail, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &cloudtrail.RemoveTagsInput{
+			ResourceId: aws.String(identifier),
+			TagsList:   removedTags.CloudtrailTags(),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &cloudtrail.AddTagsInput{
+			ResourceId: aws.String(identifier),
+			TagsList:   updatedTags.CloudtrailTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CloudwatchUpdateTags updates cloudwatch service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CloudwatchUpdateTags(conn *cloudwatch.CloudWatch, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &cloudwatch.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &cloudwatch.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.CloudwatchTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CloudwatcheventsUpdateTags updates cloudwatchevents service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CloudwatcheventsUpdateTags(conn *cloudwatchevents.CloudWatchEvents, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &cloudwatchevents.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &cloudwatchevents.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.CloudwatcheventsTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CloudwatchlogsUpdateTags updates cloudwatchlogs service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CloudwatchlogsUpdateTags(conn *cloudwatchlogs.CloudWatchLogs, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &cloudwatchlogs.UntagLogGroupInput{
+			LogGroupName: aws.String(identifier),
+			Tags:         aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagLogGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &cloudwatchlogs.TagLogGroupInput{
+			LogGroupName: aws.String(identifier),
+			Tags:         updatedTags.CloudwatchlogsTags(),
+		}
+
+		_, err := conn.TagLogGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CodecommitUpdateTags updates codecommit service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CodecommitUpdateTags(conn *codecommit.CodeCommit, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &codecommit.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &codecommit.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.CodecommitTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CodedeployUpdateTags updates codedeploy service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CodedeployUpdateTags(conn *codedeploy.CodeDeploy, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &codedeploy.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &codedeploy.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.CodedeployTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CodepipelineUpdateTags updates codepipeline service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CodepipelineUpdateTags(conn *codepipeline.CodePipeline, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &codepipeline.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &codepipeline.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.CodepipelineTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CognitoidentityUpdateTags updates cognitoidentity service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CognitoidentityUpdateTags(conn *cognitoidentity.CognitoIdentity, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &cognitoidentity.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &cognitoidentity.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.CognitoidentityTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CognitoidentityproviderUpdateTags updates cognitoidentityprovider service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func CognitoidentityproviderUpdateTags(conn *cognitoidentityprovider.CognitoIdentityProvider, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &cognitoidentityprovider.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &cognitoidentityprovider.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.CognitoidentityproviderTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ConfigserviceUpdateTags updates configservice service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func ConfigserviceUpdateTags(conn *configservice.ConfigService, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &configservice.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &configservice.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.ConfigserviceTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DatabasemigrationserviceUpdateTags updates databasemigrationservice service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DatabasemigrationserviceUpdateTags(conn *databasemigrationservice.DatabaseMigrationService, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &databasemigrationservice.RemoveTagsFromResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &databasemigrationservice.AddTagsToResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.DatabasemigrationserviceTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DatapipelineUpdateTags updates datapipeline service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DatapipelineUpdateTags(conn *datapipeline.DataPipeline, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &datapipeline.RemoveTagsInput{
+			PipelineId: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &datapipeline.AddTagsInput{
+			PipelineId: aws.String(identifier),
+			Tags:       updatedTags.DatapipelineTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DatasyncUpdateTags updates datasync service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DatasyncUpdateTags(conn *datasync.DataSync, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &datasync.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Keys:        aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &datasync.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.DatasyncTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DaxUpdateTags updates dax service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DaxUpdateTags(conn *dax.DAX, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &dax.UntagResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &dax.TagResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.DaxTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DevicefarmUpdateTags updates devicefarm service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DevicefarmUpdateTags(conn *devicefarm.DeviceFarm, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &devicefarm.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &devicefarm.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.DevicefarmTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DirectconnectUpdateTags updates directconnect service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DirectconnectUpdateTags(conn *directconnect.DirectConnect, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &directconnect.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &directconnect.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.DirectconnectTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DirectoryserviceUpdateTags updates directoryservice service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DirectoryserviceUpdateTags(conn *directoryservice.DirectoryService, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &directoryservice.RemoveTagsFromResourceInput{
+			ResourceId: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &directoryservice.AddTagsToResourceInput{
+			ResourceId: aws.String(identifier),
+			Tags:       updatedTags.DirectoryserviceTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DocdbUpdateTags updates docdb service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DocdbUpdateTags(conn *docdb.DocDB, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &docdb.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &docdb.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.DocdbTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DynamodbUpdateTags updates dynamodb service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func DynamodbUpdateTags(conn *dynamodb.DynamoDB, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &dynamodb.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &dynamodb.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.DynamodbTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// Ec2UpdateTags updates ec2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func Ec2UpdateTags(conn *ec2.EC2, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ec2.DeleteTagsInput{
+			Resources: aws.StringSlice([]string{identifier}),
+			Tags:      removedTags.Ec2Tags(),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ec2.CreateTagsInput{
+			Resources: aws.StringSlice([]string{identifier}),
+			Tags:      updatedTags.Ec2Tags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// EcrUpdateTags updates ecr service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func EcrUpdateTags(conn *ecr.ECR, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ecr.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ecr.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.EcrTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// EcsUpdateTags updates ecs service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func EcsUpdateTags(conn *ecs.ECS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ecs.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ecs.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.EcsTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// EfsUpdateTags updates efs service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func EfsUpdateTags(conn *efs.EFS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &efs.DeleteTagsInput{
+			FileSystemId: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &efs.CreateTagsInput{
+			FileSystemId: aws.String(identifier),
+			Tags:         updatedTags.EfsTags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ElasticacheUpdateTags updates elasticache service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func ElasticacheUpdateTags(conn *elasticache.ElastiCache, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &elasticache.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &elasticache.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.ElasticacheTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ElasticsearchserviceUpdateTags updates elasticsearchservice service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func ElasticsearchserviceUpdateTags(conn *elasticsearchservice.ElasticsearchService, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &elasticsearchservice.RemoveTagsInput{
+			ARN:     aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &elasticsearchservice.AddTagsInput{
+			ARN:     aws.String(identifier),
+			TagList: updatedTags.ElasticsearchserviceTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// Elbv2UpdateTags updates elbv2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func Elbv2UpdateTags(conn *elbv2.ELBV2, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &elbv2.RemoveTagsInput{
+			ResourceArns: aws.StringSlice([]string{identifier}),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &elbv2.AddTagsInput{
+			ResourceArns: aws.StringSlice([]string{identifier}),
+			Tags:         updatedTags.Elbv2Tags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// EmrUpdateTags updates emr service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func EmrUpdateTags(conn *emr.EMR, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &emr.RemoveTagsInput{
+			ResourceId: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &emr.AddTagsInput{
+			ResourceId: aws.String(identifier),
+			Tags:       updatedTags.EmrTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// FirehoseUpdateTags updates firehose service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func FirehoseUpdateTags(conn *firehose.Firehose, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &firehose.UntagDeliveryStreamInput{
+			DeliveryStreamName: aws.String(identifier),
+			TagKeys:            aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagDeliveryStream(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &firehose.TagDeliveryStreamInput{
+			DeliveryStreamName: aws.String(identifier),
+			Tags:               updatedTags.FirehoseTags(),
+		}
+
+		_, err := conn.TagDeliveryStream(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// FsxUpdateTags updates fsx service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func FsxUpdateTags(conn *fsx.FSx, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &fsx.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &fsx.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.FsxTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// GlueUpdateTags updates glue service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func GlueUpdateTags(conn *glue.Glue, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &glue.UntagResourceInput{
+			ResourceArn:  aws.String(identifier),
+			TagsToRemove: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &glue.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagsToAdd:   updatedTags.GlueTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// GuarddutyUpdateTags updates guardduty service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func GuarddutyUpdateTags(conn *guardduty.GuardDuty, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &guardduty.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &guardduty.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.GuarddutyTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// IotUpdateTags updates iot service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func IotUpdateTags(conn *iot.IoT, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &iot.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &iot.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.IotTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// KafkaUpdateTags updates kafka service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func KafkaUpdateTags(conn *kafka.Kafka, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &kafka.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &kafka.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.KafkaTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// KinesisUpdateTags updates kinesis service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+// Requests are split to respect the service limit of 10 tags per request.
+func KinesisUpdateTags(conn *kinesis.Kinesis, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		for _, removedTags := range removedTags.Chunks(10) {
+			input := &kinesis.RemoveTagsFromStreamInput{
+				StreamName: aws.String(identifier),
+				TagKeys:    aws.StringSlice(removedTags.Keys()),
+			}
+
+			_, err := conn.RemoveTagsFromStream(input)
+
+			if err != nil {
+				return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+			}
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		for _, updatedTags := range updatedTags.Chunks(10) {
+			input := &kinesis.AddTagsToStreamInput{
+				StreamName: aws.String(identifier),
+				Tags:       aws.StringMap(updatedTags.Map()),
+			}
+
+			_, err := conn.AddTagsToStream(input)
+
+			if err != nil {
+				return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// KinesisanalyticsUpdateTags updates kinesisanalytics service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func KinesisanalyticsUpdateTags(conn *kinesisanalytics.KinesisAnalytics, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &kinesisanalytics.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &kinesisanalytics.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.KinesisanalyticsTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// Kinesisanalyticsv2UpdateTags updates kinesisanalyticsv2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func Kinesisanalyticsv2UpdateTags(conn *kinesisanalyticsv2.KinesisAnalyticsV2, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &kinesisanalyticsv2.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &kinesisanalyticsv2.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.Kinesisanalyticsv2Tags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// KinesisvideoUpdateTags updates kinesisvideo service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func KinesisvideoUpdateTags(conn *kinesisvideo.KinesisVideo, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &kinesisvideo.UntagStreamInput{
+			StreamARN:  aws.String(identifier),
+			TagKeyList: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagStream(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &kinesisvideo.TagStreamInput{
+			StreamARN: aws.String(identifier),
+			Tags:      updatedTags.KinesisvideoTags(),
+		}
+
+		_, err := conn.TagStream(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// KmsUpdateTags updates kms service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func KmsUpdateTags(conn *kms.KMS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &kms.UntagResourceInput{
+			KeyId:   aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &kms.TagResourceInput{
+			KeyId: aws.String(identifier),
+			Tags:  updatedTags.KmsTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// LambdaUpdateTags updates lambda service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func LambdaUpdateTags(conn *lambda.Lambda, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &lambda.UntagResourceInput{
+			Resource: aws.String(identifier),
+			TagKeys:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &lambda.TagResourceInput{
+			Resource: aws.String(identifier),
+			Tags:     updatedTags.LambdaTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// LicensemanagerUpdateTags updates licensemanager service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func LicensemanagerUpdateTags(conn *licensemanager.LicenseManager, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &licensemanager.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &licensemanager.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.LicensemanagerTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// LightsailUpdateTags updates lightsail service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func LightsailUpdateTags(conn *lightsail.Lightsail, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &lightsail.UntagResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &lightsail.TagResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.LightsailTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// MediaconnectUpdateTags updates mediaconnect service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func MediaconnectUpdateTags(conn *mediaconnect.MediaConnect, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &mediaconnect.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &mediaconnect.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.MediaconnectTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// MediaconvertUpdateTags updates mediaconvert service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func MediaconvertUpdateTags(conn *mediaconvert.MediaConvert, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &mediaconvert.UntagResourceInput{
+			Arn:     aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &mediaconvert.TagResourceInput{
+			Arn:  aws.String(identifier),
+			Tags: updatedTags.MediaconvertTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// MedialiveUpdateTags updates medialive service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func MedialiveUpdateTags(conn *medialive.MediaLive, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &medialive.DeleteTagsInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &medialive.CreateTagsInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.MedialiveTags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// MediapackageUpdateTags updates mediapackage service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func MediapackageUpdateTags(conn *mediapackage.MediaPackage, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &mediapackage.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &mediapackage.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.MediapackageTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// MediastoreUpdateTags updates mediastore service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func MediastoreUpdateTags(conn *mediastore.MediaStore, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &mediastore.UntagResourceInput{
+			Resource: aws.String(identifier),
+			TagKeys:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &mediastore.TagResourceInput{
+			Resource: aws.String(identifier),
+			Tags:     updatedTags.MediastoreTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// MqUpdateTags updates mq service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func MqUpdateTags(conn *mq.MQ, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &mq.DeleteTagsInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &mq.CreateTagsInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.MqTags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// NeptuneUpdateTags updates neptune service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func NeptuneUpdateTags(conn *neptune.Neptune, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &neptune.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &neptune.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.NeptuneTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// OpsworksUpdateTags updates opsworks service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func OpsworksUpdateTags(conn *opsworks.OpsWorks, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &opsworks.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &opsworks.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.OpsworksTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// OrganizationsUpdateTags updates organizations service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func OrganizationsUpdateTags(conn *organizations.Organizations, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &organizations.UntagResourceInput{
+			ResourceId: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &organizations.TagResourceInput{
+			ResourceId: aws.String(identifier),
+			Tags:       updatedTags.OrganizationsTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// RamUpdateTags updates ram service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func RamUpdateTags(conn *ram.RAM, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ram.UntagResourceInput{
+			ResourceShareArn: aws.String(identifier),
+			TagKeys:          aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ram.TagResourceInput{
+			ResourceShareArn: aws.String(identifier),
+			Tags:             updatedTags.RamTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// RdsUpdateTags updates rds service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func RdsUpdateTags(conn *rds.RDS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &rds.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &rds.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.RdsTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// RedshiftUpdateTags updates redshift service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func RedshiftUpdateTags(conn *redshift.Redshift, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &redshift.DeleteTagsInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &redshift.CreateTagsInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.RedshiftTags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ResourcegroupsUpdateTags updates resourcegroups service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func ResourcegroupsUpdateTags(conn *resourcegroups.ResourceGroups, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &resourcegroups.UntagInput{
+			Arn:  aws.String(identifier),
+			Keys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.Untag(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &resourcegroups.TagInput{
+			Arn:  aws.String(identifier),
+			Tags: updatedTags.ResourcegroupsTags(),
+		}
+
+		_, err := conn.Tag(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// Route53resolverUpdateTags updates route53resolver service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func Route53resolverUpdateTags(conn *route53resolver.Route53Resolver, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &route53resolver.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &route53resolver.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.Route53resolverTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SagemakerUpdateTags updates sagemaker service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func SagemakerUpdateTags(conn *sagemaker.SageMaker, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &sagemaker.DeleteTagsInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &sagemaker.AddTagsInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.SagemakerTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SecretsmanagerUpdateTags updates secretsmanager service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func SecretsmanagerUpdateTags(conn *secretsmanager.SecretsManager, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &secretsmanager.UntagResourceInput{
+			SecretId: aws.String(identifier),
+			TagKeys:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &secretsmanager.TagResourceInput{
+			SecretId: aws.String(identifier),
+			Tags:     updatedTags.SecretsmanagerTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SecurityhubUpdateTags updates securityhub service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func SecurityhubUpdateTags(conn *securityhub.SecurityHub, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &securityhub.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &securityhub.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.SecurityhubTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SfnUpdateTags updates sfn service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func SfnUpdateTags(conn *sfn.SFN, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &sfn.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &sfn.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.SfnTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SnsUpdateTags updates sns service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func SnsUpdateTags(conn *sns.SNS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &sns.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &sns.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.SnsTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SqsUpdateTags updates sqs service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func SqsUpdateTags(conn *sqs.SQS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &sqs.UntagQueueInput{
+			QueueUrl: aws.String(identifier),
+			TagKeys:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagQueue(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &sqs.TagQueueInput{
+			QueueUrl: aws.String(identifier),
+			Tags:     updatedTags.SqsTags(),
+		}
+
+		_, err := conn.TagQueue(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SsmUpdateTags updates ssm service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func SsmUpdateTags(conn *ssm.SSM, identifier string, resourceType string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ssm.RemoveTagsFromResourceInput{
+			ResourceId:   aws.String(identifier),
+			ResourceType: aws.String(resourceType),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ssm.AddTagsToResourceInput{
+			ResourceId:   aws.String(identifier),
+			ResourceType: aws.String(resourceType),
+			Tags:         updatedTags.SsmTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// StoragegatewayUpdateTags updates storagegateway service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func StoragegatewayUpdateTags(conn *storagegateway.StorageGateway, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &storagegateway.RemoveTagsFromResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &storagegateway.AddTagsToResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.StoragegatewayTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SwfUpdateTags updates swf service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func SwfUpdateTags(conn *swf.SWF, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &swf.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &swf.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.SwfTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// TransferUpdateTags updates transfer service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func TransferUpdateTags(conn *transfer.Transfer, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &transfer.UntagResourceInput{
+			Arn:     aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &transfer.TagResourceInput{
+			Arn:  aws.String(identifier),
+			Tags: updatedTags.TransferTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// WafUpdateTags updates waf service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func WafUpdateTags(conn *waf.WAF, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &waf.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &waf.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.WafTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// WorkspacesUpdateTags updates workspaces service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// Removed tags are untagged before added and updated tags are tagged.
+func WorkspacesUpdateTags(conn *workspaces.WorkSpaces, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &workspaces.DeleteTagsInput{
+			ResourceId: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &workspaces.CreateTagsInput{
+			ResourceId: aws.String(identifier),
+			Tags:       updatedTags.WorkspacesTags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
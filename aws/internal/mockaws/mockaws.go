@@ -0,0 +1,396 @@
+// Package mockaws provides an in-process HTTP stand-in for AWS service APIs.
+// Service clients are pointed at the server through their endpoint
+// configuration and every API operation they call is answered from a
+// scripted sequence of responses, so resource CRUD, waiters and retry logic
+// can be exercised without credentials or network access.
+package mockaws
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Protocol is the wire protocol of an AWS service. It determines how the
+// operation name is read from a request and how error responses are encoded.
+type Protocol int
+
+const (
+	// ProtocolEC2 is the EC2 query protocol.
+	ProtocolEC2 Protocol = iota
+
+	// ProtocolQuery is the AWS query protocol used by e.g. IAM, RDS and STS.
+	ProtocolQuery
+
+	// ProtocolJSON is the AWS JSON protocol used by e.g. DynamoDB and ECS.
+	ProtocolJSON
+
+	// ProtocolRESTJSON is the REST-JSON protocol used by e.g. Lambda and EFS.
+	ProtocolRESTJSON
+
+	// ProtocolRESTXML is the REST-XML protocol used by e.g. S3 and Route 53.
+	ProtocolRESTXML
+)
+
+// RequestID is returned in every response.
+const RequestID = "00000000-0000-0000-0000-000000000000"
+
+// UnmatchedErrorCode is the error code returned for requests that have no
+// matching stub.
+const UnmatchedErrorCode = "MockAwsUnmatchedRequest"
+
+// Request is a request received by the server.
+type Request struct {
+	Service   string
+	Operation string
+	Method    string
+	Path      string
+	Header    http.Header
+	Body      string
+
+	// Params holds the form parameters of query protocol requests.
+	Params url.Values
+
+	matched bool
+}
+
+// Response is a scripted response to an API operation.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+}
+
+// Stub holds the scripted responses for a single API operation.
+// Responses are returned in order. Once only one remains it is repeated
+// for all further requests.
+type Stub struct {
+	service   string
+	operation string
+	protocol  Protocol
+	match     func(*Request) bool
+	responses []*Response
+}
+
+// Server is an in-process stand-in for one or more AWS services.
+type Server struct {
+	server *httptest.Server
+
+	mu        sync.Mutex
+	protocols map[string]Protocol
+	stubs     []*Stub
+	requests  []*Request
+}
+
+// NewServer starts a new server. It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		protocols: make(map[string]Protocol),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Endpoint registers a service with the server and returns the endpoint URL
+// to configure its client with.
+func (s *Server) Endpoint(service string, protocol Protocol) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.protocols[service] = protocol
+
+	return fmt.Sprintf("%s/%s", s.server.URL, service)
+}
+
+// On returns a new stub for an operation of a registered service.
+// For the REST protocols the operation is the HTTP method and the request
+// path without the service prefix, e.g. "GET /2015-03-31/functions/example".
+// Stubs are matched in the order they were added.
+func (s *Server) On(service, operation string) *Stub {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	protocol, ok := s.protocols[service]
+	if !ok {
+		panic(fmt.Sprintf("mockaws: service %q is not registered, call Endpoint first", service))
+	}
+
+	stub := &Stub{
+		service:   service,
+		operation: operation,
+		protocol:  protocol,
+	}
+	s.stubs = append(s.stubs, stub)
+
+	return stub
+}
+
+// Requests returns all requests received for an operation of a service.
+func (s *Server) Requests(service, operation string) []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []*Request
+	for _, r := range s.requests {
+		if r.Service == service && r.Operation == operation {
+			requests = append(requests, r)
+		}
+	}
+
+	return requests
+}
+
+// Operations returns the distinct operations received for a service,
+// sorted by name.
+func (s *Server) Operations(service string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool)
+	var operations []string
+	for _, r := range s.requests {
+		if r.Service == service && !seen[r.Operation] {
+			seen[r.Operation] = true
+			operations = append(operations, r.Operation)
+		}
+	}
+	sort.Strings(operations)
+
+	return operations
+}
+
+// Unmatched returns the requests that did not match any stub.
+func (s *Server) Unmatched() []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []*Request
+	for _, r := range s.requests {
+		if !r.matched {
+			requests = append(requests, r)
+		}
+	}
+
+	return requests
+}
+
+// When restricts the stub to requests for which f returns true, e.g. to
+// return different responses for different resource identifiers.
+func (st *Stub) When(f func(*Request) bool) *Stub {
+	st.match = f
+	return st
+}
+
+// WhenParam restricts the stub to query protocol requests with the given
+// form parameter value, e.g. WhenParam("GroupId.1", "sg-12345678").
+func (st *Stub) WhenParam(key, value string) *Stub {
+	return st.When(func(r *Request) bool {
+		return r.Params.Get(key) == value
+	})
+}
+
+// Respond adds a successful response with the given body.
+func (st *Stub) Respond(body string) *Stub {
+	return st.RespondWithStatus(http.StatusOK, body)
+}
+
+// RespondWithStatus adds a response with the given status code and body.
+func (st *Stub) RespondWithStatus(statusCode int, body string) *Stub {
+	st.responses = append(st.responses, &Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{st.contentType()}},
+		Body:       body,
+	})
+
+	return st
+}
+
+// RespondError adds an error response encoded for the service's protocol.
+func (st *Stub) RespondError(statusCode int, code, message string) *Stub {
+	header := http.Header{"Content-Type": []string{st.contentType()}}
+	var body string
+
+	switch st.protocol {
+	case ProtocolEC2:
+		body = fmt.Sprintf(`<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>%s</RequestID></Response>`, xmlEscape(code), xmlEscape(message), RequestID)
+	case ProtocolQuery:
+		body = fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, xmlEscape(code), xmlEscape(message), RequestID)
+	case ProtocolJSON:
+		body = fmt.Sprintf(`{"__type":%q,"message":%q}`, code, message)
+	case ProtocolRESTJSON:
+		header.Set("X-Amzn-Errortype", code)
+		body = fmt.Sprintf(`{"message":%q}`, message)
+	case ProtocolRESTXML:
+		// S3 error responses are not wrapped in an ErrorResponse element
+		if st.service == "s3" {
+			body = fmt.Sprintf(`<Error><Code>%s</Code><Message>%s</Message><RequestId>%s</RequestId></Error>`, xmlEscape(code), xmlEscape(message), RequestID)
+			break
+		}
+		body = fmt.Sprintf(`<ErrorResponse><Error><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, xmlEscape(code), xmlEscape(message), RequestID)
+	}
+
+	st.responses = append(st.responses, &Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       body,
+	})
+
+	return st
+}
+
+// Throttle adds a throttling error response. AWS SDK clients retry it.
+func (st *Stub) Throttle() *Stub {
+	code := "ThrottlingException"
+	switch st.protocol {
+	case ProtocolEC2:
+		code = "RequestLimitExceeded"
+	case ProtocolQuery:
+		code = "Throttling"
+	}
+
+	return st.RespondError(http.StatusBadRequest, code, "Rate exceeded")
+}
+
+// Times repeats the most recently added response so it is returned n times
+// in total.
+func (st *Stub) Times(n int) *Stub {
+	if len(st.responses) == 0 {
+		panic("mockaws: Times called before adding a response")
+	}
+
+	last := st.responses[len(st.responses)-1]
+	for i := 1; i < n; i++ {
+		st.responses = append(st.responses, last)
+	}
+
+	return st
+}
+
+func (st *Stub) next() *Response {
+	if len(st.responses) == 0 {
+		return nil
+	}
+
+	response := st.responses[0]
+	if len(st.responses) > 1 {
+		st.responses = st.responses[1:]
+	}
+
+	return response
+}
+
+func (st *Stub) contentType() string {
+	switch st.protocol {
+	case ProtocolJSON:
+		return "application/x-amz-json-1.1"
+	case ProtocolRESTJSON:
+		return "application/json"
+	}
+
+	return "text/xml"
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, httpReq *http.Request) {
+	body, err := ioutil.ReadAll(httpReq.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading request body: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.newRequest(httpReq, string(body))
+	s.requests = append(s.requests, r)
+
+	var response *Response
+	if stub := s.findStub(r); stub != nil {
+		response = stub.next()
+	}
+
+	r.matched = response != nil
+
+	if response == nil {
+		stub := &Stub{service: r.Service, protocol: s.protocols[r.Service]}
+		stub.RespondError(http.StatusBadRequest, UnmatchedErrorCode, fmt.Sprintf("no mock response for %s %s", r.Service, r.Operation))
+		response = stub.responses[0]
+	}
+
+	for k, v := range response.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Set("X-Amzn-Requestid", RequestID)
+	w.WriteHeader(response.StatusCode)
+	fmt.Fprint(w, response.Body)
+}
+
+func (s *Server) newRequest(httpReq *http.Request, body string) *Request {
+	path := strings.TrimPrefix(httpReq.URL.Path, "/")
+	service := path
+	if i := strings.Index(path, "/"); i >= 0 {
+		service = path[:i]
+		path = path[i:]
+	} else {
+		path = "/"
+	}
+
+	r := &Request{
+		Service: service,
+		Method:  httpReq.Method,
+		Path:    path,
+		Header:  httpReq.Header,
+		Body:    body,
+	}
+
+	switch s.protocols[service] {
+	case ProtocolEC2, ProtocolQuery:
+		r.Params, _ = url.ParseQuery(body)
+		r.Operation = r.Params.Get("Action")
+	case ProtocolJSON:
+		target := httpReq.Header.Get("X-Amz-Target")
+		r.Operation = target[strings.LastIndex(target, ".")+1:]
+	default:
+		r.Operation = fmt.Sprintf("%s %s", httpReq.Method, path)
+	}
+
+	return r
+}
+
+func (s *Server) findStub(r *Request) *Stub {
+	for _, stub := range s.stubs {
+		if stub.service != r.Service || stub.operation != r.Operation {
+			continue
+		}
+
+		if stub.match != nil && !stub.match(r) {
+			continue
+		}
+
+		return stub
+	}
+
+	return nil
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s)) // nolint:errcheck
+	return b.String()
+}
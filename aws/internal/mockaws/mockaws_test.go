@@ -0,0 +1,162 @@
+package mockaws
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+func testSession(t *testing.T, endpoint string, maxRetries int) *session.Session {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock", "mock", ""),
+		Endpoint:    aws.String(endpoint),
+		MaxRetries:  aws.Int(maxRetries),
+		Region:      aws.String("us-west-2"),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+func TestServerRespond(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := ec2.New(testSession(t, s.Endpoint("ec2", ProtocolEC2), 0))
+
+	s.On("ec2", "DescribeVpcs").WhenParam("VpcId.1", "vpc-12345678").Respond(`
+<DescribeVpcsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <vpcSet>
+    <item>
+      <vpcId>vpc-12345678</vpcId>
+      <cidrBlock>10.0.0.0/16</cidrBlock>
+    </item>
+  </vpcSet>
+</DescribeVpcsResponse>`)
+
+	output, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{"vpc-12345678"}),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(output.Vpcs) != 1 || aws.StringValue(output.Vpcs[0].CidrBlock) != "10.0.0.0/16" {
+		t.Fatalf("unexpected output: %s", output)
+	}
+
+	if got := len(s.Requests("ec2", "DescribeVpcs")); got != 1 {
+		t.Fatalf("expected 1 DescribeVpcs request, got %d", got)
+	}
+
+	if got := len(s.Unmatched()); got != 0 {
+		t.Fatalf("expected no unmatched requests, got %d", got)
+	}
+}
+
+func TestServerRespondSequence(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := ec2.New(testSession(t, s.Endpoint("ec2", ProtocolEC2), 0))
+
+	s.On("ec2", "DeleteVpc").
+		RespondError(http.StatusBadRequest, "DependencyViolation", "The vpc has dependencies and cannot be deleted.").Times(2).
+		Respond(`<DeleteVpcResponse><return>true</return></DeleteVpcResponse>`)
+
+	for i := 0; i < 2; i++ {
+		_, err := conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-12345678")})
+
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "DependencyViolation" {
+			t.Fatalf("request %d: expected DependencyViolation error, got: %#v", i, err)
+		}
+	}
+
+	// The last response is repeated
+	for i := 0; i < 2; i++ {
+		if _, err := conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-12345678")}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func TestServerThrottle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := iam.New(testSession(t, s.Endpoint("iam", ProtocolQuery), 3))
+
+	s.On("iam", "GetUser").
+		Throttle().Times(2).
+		Respond(`
+<GetUserResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <GetUserResult>
+    <User>
+      <UserName>example</UserName>
+      <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+      <Path>/</Path>
+      <Arn>arn:aws:iam::123456789012:user/example</Arn>
+      <CreateDate>2019-01-01T00:00:00Z</CreateDate>
+    </User>
+  </GetUserResult>
+</GetUserResponse>`)
+
+	output, err := conn.GetUser(&iam.GetUserInput{UserName: aws.String("example")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if aws.StringValue(output.User.UserName) != "example" {
+		t.Fatalf("unexpected output: %s", output)
+	}
+
+	if got := len(s.Requests("iam", "GetUser")); got != 3 {
+		t.Fatalf("expected 3 GetUser requests, got %d", got)
+	}
+}
+
+func TestServerJSONError(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := dynamodb.New(testSession(t, s.Endpoint("dynamodb", ProtocolJSON), 0))
+
+	s.On("dynamodb", "DescribeTable").RespondError(http.StatusBadRequest, dynamodb.ErrCodeResourceNotFoundException, "Requested resource not found")
+
+	_, err := conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("example")})
+
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != dynamodb.ErrCodeResourceNotFoundException {
+		t.Fatalf("expected %s error, got: %#v", dynamodb.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestServerUnmatched(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := ec2.New(testSession(t, s.Endpoint("ec2", ProtocolEC2), 0))
+
+	_, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{})
+
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != UnmatchedErrorCode {
+		t.Fatalf("expected %s error, got: %#v", UnmatchedErrorCode, err)
+	}
+
+	unmatched := s.Unmatched()
+
+	if len(unmatched) != 1 || unmatched[0].Operation != "DescribeVpcs" {
+		t.Fatalf("expected unmatched DescribeVpcs request, got: %#v", unmatched)
+	}
+}
@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// MetricsLogInterval is the number of requests to a service between
+	// logging its limiter metrics.
+	MetricsLogInterval = 100
+
+	// Request handler names, for use with the AWS Go SDK named handler lists.
+	WaitHandlerName      = "terraform-provider-aws.ratelimit.Wait"
+	ThrottledHandlerName = "terraform-provider-aws.ratelimit.Throttled"
+	CompleteHandlerName  = "terraform-provider-aws.ratelimit.Complete"
+)
+
+// Limiters maps service names to their limiter. The provider keys them by
+// the names of the endpoints configuration block, e.g. ec2 or elb.
+type Limiters map[string]*Limiter
+
+// NewLimiters creates Limiters from configurations keyed by service name.
+func NewLimiters(configs map[string]Config) Limiters {
+	limiters := make(Limiters, len(configs))
+
+	for service, config := range configs {
+		limiters[service] = NewLimiter(config)
+	}
+
+	return limiters
+}
+
+// InstallHandlers adds request handlers which apply the named service's
+// limiter, if any, to all requests made by clients created with the given
+// handlers. Clients sharing a service name, e.g. Elastic Load Balancing and
+// Elastic Load Balancing v2, share its limiter.
+func (limiters Limiters) InstallHandlers(service string, handlers *request.Handlers) {
+	if limiter, ok := limiters[service]; ok {
+		limiter.InstallHandlers(handlers)
+	}
+}
+
+// InstallHandlers adds request handlers which apply the limiter to all
+// requests made by clients created with the given handlers. Each attempt,
+// including retries, waits on the limiter before being signed, throttling
+// errors reduce the rate and successful requests restore it.
+func (l *Limiter) InstallHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{Name: WaitHandlerName, Fn: l.wait})
+	handlers.Retry.PushBackNamed(request.NamedHandler{Name: ThrottledHandlerName, Fn: l.throttled})
+	handlers.Complete.PushBackNamed(request.NamedHandler{Name: CompleteHandlerName, Fn: l.complete})
+}
+
+func (l *Limiter) wait(r *request.Request) {
+	waited, err := l.Wait(r.Context())
+
+	if err != nil {
+		r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting on API rate limit", err)
+		return
+	}
+
+	if waited > 0 {
+		log.Printf("[DEBUG] API rate limit: delayed %s/%s request by %s (rate: %.2f requests/second)",
+			r.ClientInfo.ServiceName, r.Operation.Name, waited, l.Metrics().Rate)
+	}
+}
+
+func (l *Limiter) throttled(r *request.Request) {
+	if !request.IsErrorThrottle(r.Error) {
+		return
+	}
+
+	rate := l.Throttled()
+
+	log.Printf("[DEBUG] API rate limit: %s/%s request throttled (%s), reducing rate to %.2f requests/second",
+		r.ClientInfo.ServiceName, r.Operation.Name, r.Error, rate)
+}
+
+func (l *Limiter) complete(r *request.Request) {
+	if r.Error == nil {
+		l.Succeeded()
+	}
+
+	if metrics := l.Metrics(); metrics.Requests%MetricsLogInterval == 0 {
+		log.Printf("[DEBUG] API rate limit metrics for %s: %d requests, %d throttled, %s delayed (rate: %.2f requests/second)",
+			r.ClientInfo.ServiceName, metrics.Requests, metrics.Throttles, metrics.Waited, metrics.Rate)
+	}
+}
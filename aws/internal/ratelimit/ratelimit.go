@@ -0,0 +1,159 @@
+// Package ratelimit provides client-side rate limiting of AWS API requests.
+// Each AWS service is assigned a token bucket whose refill rate adapts to
+// throttling errors returned by the service: the rate is reduced
+// multiplicatively when a request is throttled and recovers additively as
+// requests succeed, so heavily throttled accounts slow down instead of
+// exhausting their retries.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+const (
+	// ThrottledRateFactor is the factor applied to the current rate each
+	// time a request is throttled.
+	ThrottledRateFactor = 0.7
+
+	// MinimumRateFactor is the lowest fraction of the configured rate the
+	// limiter will reduce to after repeated throttling.
+	MinimumRateFactor = 0.1
+
+	// SucceededRateFactor is the fraction of the configured rate restored
+	// after each successful request.
+	SucceededRateFactor = 0.05
+)
+
+// Config contains the configuration of a limiter for a single service.
+type Config struct {
+	// RequestsPerSecond is the sustained request rate.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of requests that can be sent at once.
+	// Defaults to RequestsPerSecond rounded up, with a minimum of 1.
+	Burst int
+}
+
+// Metrics contains a snapshot of a limiter's counters.
+type Metrics struct {
+	Rate      float64
+	Requests  int64
+	Throttles int64
+	Waited    time.Duration
+}
+
+// Limiter is an adaptive token bucket rate limiter. It is safe for
+// concurrent use.
+type Limiter struct {
+	mu sync.Mutex
+
+	maxRate float64
+	minRate float64
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+
+	requests  int64
+	throttles int64
+	waited    time.Duration
+
+	// now is replaced in tests to control the passage of time.
+	now func() time.Time
+}
+
+// NewLimiter creates a Limiter from the given configuration, starting with a
+// full bucket.
+func NewLimiter(config Config) *Limiter {
+	burst := float64(config.Burst)
+
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(config.RequestsPerSecond))
+	}
+
+	return &Limiter{
+		maxRate: config.RequestsPerSecond,
+		minRate: config.RequestsPerSecond * MinimumRateFactor,
+		rate:    config.RequestsPerSecond,
+		burst:   burst,
+		tokens:  burst,
+		now:     time.Now,
+	}
+}
+
+// Reserve takes a token from the bucket and returns how long the caller must
+// wait before sending its request. Tokens may be borrowed against future
+// refills, so concurrent callers are queued in order.
+func (l *Limiter) Reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+
+	l.last = now
+	l.tokens--
+	l.requests++
+
+	if l.tokens >= 0 || l.rate <= 0 {
+		return 0
+	}
+
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.waited += wait
+
+	return wait
+}
+
+// Wait blocks until the caller may send its request or the context is
+// cancelled. It returns the duration reserved for the caller.
+func (l *Limiter) Wait(ctx aws.Context) (time.Duration, error) {
+	wait := l.Reserve()
+
+	if wait <= 0 {
+		return 0, nil
+	}
+
+	return wait, aws.SleepWithContext(ctx, wait)
+}
+
+// Throttled reduces the rate after the service throttled a request and
+// returns the new rate.
+func (l *Limiter) Throttled() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.throttles++
+	l.rate = math.Max(l.minRate, l.rate*ThrottledRateFactor)
+
+	return l.rate
+}
+
+// Succeeded increases the rate towards the configured rate after a
+// successful request.
+func (l *Limiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = math.Min(l.maxRate, l.rate+l.maxRate*SucceededRateFactor)
+}
+
+// Metrics returns a snapshot of the limiter's counters and current rate.
+func (l *Limiter) Metrics() Metrics {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return Metrics{
+		Rate:      l.rate,
+		Requests:  l.requests,
+		Throttles: l.throttles,
+		Waited:    l.waited,
+	}
+}
@@ -0,0 +1,214 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func testLimiter(config Config) (*Limiter, *testClock) {
+	clock := &testClock{now: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)}
+	limiter := NewLimiter(config)
+	limiter.now = clock.Now
+
+	return limiter, clock
+}
+
+func TestNewLimiter(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        Config
+		expectedBurst float64
+	}{
+		{
+			name:          "burst",
+			config:        Config{RequestsPerSecond: 5, Burst: 2},
+			expectedBurst: 2,
+		},
+		{
+			name:          "default burst",
+			config:        Config{RequestsPerSecond: 2.5},
+			expectedBurst: 3,
+		},
+		{
+			name:          "default burst fractional rate",
+			config:        Config{RequestsPerSecond: 0.5},
+			expectedBurst: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			limiter := NewLimiter(testCase.config)
+
+			if limiter.burst != testCase.expectedBurst {
+				t.Errorf("expected burst %v, got %v", testCase.expectedBurst, limiter.burst)
+			}
+
+			if limiter.tokens != testCase.expectedBurst {
+				t.Errorf("expected tokens %v, got %v", testCase.expectedBurst, limiter.tokens)
+			}
+		})
+	}
+}
+
+func TestLimiterReserve(t *testing.T) {
+	limiter, clock := testLimiter(Config{RequestsPerSecond: 2, Burst: 2})
+
+	for i := 0; i < 2; i++ {
+		if got := limiter.Reserve(); got != 0 {
+			t.Fatalf("expected burst request %d to not wait, got %s", i, got)
+		}
+	}
+
+	if got, expected := limiter.Reserve(), 500*time.Millisecond; got != expected {
+		t.Fatalf("expected wait %s, got %s", expected, got)
+	}
+
+	if got, expected := limiter.Reserve(), time.Second; got != expected {
+		t.Fatalf("expected queued wait %s, got %s", expected, got)
+	}
+
+	clock.Advance(10 * time.Second)
+
+	if got := limiter.Reserve(); got != 0 {
+		t.Fatalf("expected refilled request to not wait, got %s", got)
+	}
+
+	metrics := limiter.Metrics()
+
+	if metrics.Requests != 5 {
+		t.Errorf("expected 5 requests, got %d", metrics.Requests)
+	}
+
+	if expected := 1500 * time.Millisecond; metrics.Waited != expected {
+		t.Errorf("expected waited %s, got %s", expected, metrics.Waited)
+	}
+}
+
+func TestLimiterThrottledSucceeded(t *testing.T) {
+	limiter, _ := testLimiter(Config{RequestsPerSecond: 10})
+
+	if got, expected := limiter.Throttled(), 7.0; got != expected {
+		t.Fatalf("expected rate %v, got %v", expected, got)
+	}
+
+	for i := 0; i < 20; i++ {
+		limiter.Throttled()
+	}
+
+	if got, expected := limiter.Metrics().Rate, 1.0; got != expected {
+		t.Fatalf("expected minimum rate %v, got %v", expected, got)
+	}
+
+	limiter.Succeeded()
+
+	if got, expected := limiter.Metrics().Rate, 1.5; got != expected {
+		t.Fatalf("expected rate %v, got %v", expected, got)
+	}
+
+	for i := 0; i < 100; i++ {
+		limiter.Succeeded()
+	}
+
+	if got, expected := limiter.Metrics().Rate, 10.0; got != expected {
+		t.Fatalf("expected maximum rate %v, got %v", expected, got)
+	}
+
+	if got, expected := limiter.Metrics().Throttles, int64(21); got != expected {
+		t.Errorf("expected %d throttles, got %d", expected, got)
+	}
+}
+
+func TestLimitersInstallHandlers(t *testing.T) {
+	limiters := NewLimiters(map[string]Config{
+		"ec2": {RequestsPerSecond: 10},
+	})
+	handlers := request.Handlers{}
+	limiters.InstallHandlers("ec2", &handlers)
+	unlimitedHandlers := request.Handlers{}
+	limiters.InstallHandlers("s3", &unlimitedHandlers)
+
+	if unlimitedHandlers.Sign.Len() != 0 || unlimitedHandlers.Retry.Len() != 0 || unlimitedHandlers.Complete.Len() != 0 {
+		t.Errorf("expected no handlers for unconfigured service")
+	}
+
+	testCases := []struct {
+		name              string
+		serviceName       string
+		err               error
+		expectedRate      float64
+		expectedRequests  int64
+		expectedThrottles int64
+	}{
+		{
+			name:              "throttled",
+			serviceName:       "ec2",
+			err:               awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			expectedRate:      7,
+			expectedRequests:  1,
+			expectedThrottles: 1,
+		},
+		{
+			name:              "other error",
+			serviceName:       "ec2",
+			err:               awserr.New("InvalidParameterValue", "Invalid value", nil),
+			expectedRate:      7,
+			expectedRequests:  2,
+			expectedThrottles: 1,
+		},
+		{
+			name:              "succeeded",
+			serviceName:       "ec2",
+			expectedRate:      7.5,
+			expectedRequests:  3,
+			expectedThrottles: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := request.New(aws.Config{}, metadata.ClientInfo{ServiceName: testCase.serviceName}, handlers, nil, &request.Operation{Name: "DescribeInstances"}, nil, nil)
+
+			r.Handlers.Sign.Run(r)
+
+			if r.Error != nil {
+				t.Fatalf("unexpected sign error: %s", r.Error)
+			}
+
+			r.Error = testCase.err
+			r.Handlers.Retry.Run(r)
+			r.Handlers.Complete.Run(r)
+
+			metrics := limiters["ec2"].Metrics()
+
+			if metrics.Rate != testCase.expectedRate {
+				t.Errorf("expected rate %v, got %v", testCase.expectedRate, metrics.Rate)
+			}
+
+			if metrics.Requests != testCase.expectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.expectedRequests, metrics.Requests)
+			}
+
+			if metrics.Throttles != testCase.expectedThrottles {
+				t.Errorf("expected %d throttles, got %d", testCase.expectedThrottles, metrics.Throttles)
+			}
+		})
+	}
+}
@@ -1,12 +1,16 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"sort"
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
 )
 
 // Provider returns a terraform.ResourceProvider.
@@ -15,7 +19,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -40,6 +44,8 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -88,8 +94,14 @@ func Provider() terraform.ResourceProvider {
 				Set:           schema.HashString,
 			},
 
+			"api_rate_limits": apiRateLimitsSchema(),
+
+			"default_tags": defaultTagsSchema(),
+
 			"endpoints": endpointsSchema(),
 
+			"ignore_tags": ignoreTagsSchema(),
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -321,6 +333,16 @@ func Provider() terraform.ResourceProvider {
 			"aws_api_gateway_usage_plan":                              resourceAwsApiGatewayUsagePlan(),
 			"aws_api_gateway_usage_plan_key":                          resourceAwsApiGatewayUsagePlanKey(),
 			"aws_api_gateway_vpc_link":                                resourceAwsApiGatewayVpcLink(),
+			"aws_apigatewayv2_api":                                    resourceAwsApiGatewayV2Api(),
+			"aws_apigatewayv2_api_mapping":                            resourceAwsApiGatewayV2ApiMapping(),
+			"aws_apigatewayv2_authorizer":                             resourceAwsApiGatewayV2Authorizer(),
+			"aws_apigatewayv2_deployment":                             resourceAwsApiGatewayV2Deployment(),
+			"aws_apigatewayv2_domain_name":                            resourceAwsApiGatewayV2DomainName(),
+			"aws_apigatewayv2_integration":                            resourceAwsApiGatewayV2Integration(),
+			"aws_apigatewayv2_integration_response":                   resourceAwsApiGatewayV2IntegrationResponse(),
+			"aws_apigatewayv2_model":                                  resourceAwsApiGatewayV2Model(),
+			"aws_apigatewayv2_route":                                  resourceAwsApiGatewayV2Route(),
+			"aws_apigatewayv2_stage":                                  resourceAwsApiGatewayV2Stage(),
 			"aws_app_cookie_stickiness_policy":                        resourceAwsAppCookieStickinessPolicy(),
 			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
 			"aws_appautoscaling_policy":                               resourceAwsAppautoscalingPolicy(),
@@ -491,6 +513,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_emr_instance_group":                                  resourceAwsEMRInstanceGroup(),
 			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
 			"aws_flow_log":                                            resourceAwsFlowLog(),
+			"aws_fms_admin_account":                                   resourceAwsFmsAdminAccount(),
+			"aws_fms_policy":                                          resourceAwsFmsPolicy(),
+			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
+			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
 			"aws_gamelift_alias":                                      resourceAwsGameliftAlias(),
 			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
 			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
@@ -553,6 +579,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
 			"aws_kinesis_analytics_application":                       resourceAwsKinesisAnalyticsApplication(),
+			"aws_kinesisanalyticsv2_application":                      resourceAwsKinesisAnalyticsV2Application(),
 			"aws_kms_alias":                                           resourceAwsKmsAlias(),
 			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
 			"aws_kms_grant":                                           resourceAwsKmsGrant(),
@@ -675,10 +702,19 @@ func Provider() terraform.ResourceProvider {
 			"aws_ses_template":                                        resourceAwsSesTemplate(),
 			"aws_s3_account_public_access_block":                      resourceAwsS3AccountPublicAccessBlock(),
 			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
+			"aws_s3_bucket_acl":                                       resourceAwsS3BucketAcl(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
 			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_public_access_block":                       resourceAwsS3BucketPublicAccessBlock(),
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_analytics_configuration":                   resourceAwsS3BucketAnalyticsConfiguration(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
 			"aws_security_group":                                      resourceAwsSecurityGroup(),
@@ -813,13 +849,25 @@ func Provider() terraform.ResourceProvider {
 			"aws_alb_target_group_attachment": resourceAwsLbTargetGroupAttachment(),
 			"aws_lb_target_group_attachment":  resourceAwsLbTargetGroupAttachment(),
 		},
-		ConfigureFunc: providerConfigure,
 	}
+
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider)
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
 var endpointServiceNames []string
 
+// deprecatedEndpointServiceNames maps deprecated endpoints configuration
+// block names to the names replacing them.
+var deprecatedEndpointServiceNames = map[string]string{
+	"kinesis_analytics": "kinesisanalytics",
+	"r53":               "route53",
+}
+
 func init() {
 	descriptions = map[string]string{
 		"region": "The region where AWS operations will take place. Examples\n" +
@@ -880,6 +928,35 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session. If omitted," +
+			" the role's default of one hour is used.",
+
+		"assume_role_policy_arns": "The ARNs of IAM managed policies to use as managed session" +
+			" policies. The permissions are the intersection of the role's policies and these policies.",
+
+		"assume_role_with_web_identity_role_arn": "The ARN of an IAM role to assume with a web identity token.",
+
+		"assume_role_with_web_identity_web_identity_token_file": "The path to a file containing an OAuth 2.0" +
+			" access token or OpenID Connect ID token.",
+
+		"assume_role_with_web_identity_session_name": "The session name to use when assuming the role." +
+			" If omitted, a session name is generated.",
+
+		"api_rate_limits_service": "The service to rate limit requests to, named as in the endpoints" +
+			" configuration block, e.g. ec2, elb or cloudwatch.",
+
+		"api_rate_limits_requests_per_second": "The sustained rate of API requests per second to the service." +
+			" The rate is reduced when requests are throttled and gradually restored as requests succeed.",
+
+		"api_rate_limits_burst": "The maximum number of API requests that can be sent to the service at once." +
+			" Defaults to requests_per_second rounded up.",
+
+		"default_tags_tags": "Resource tags to default across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
 	}
 
 	endpointServiceNames = []string{
@@ -1005,7 +1082,7 @@ func init() {
 	}
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, provider *schema.Provider) (interface{}, error) {
 	config := Config{
 		AccessKey:               d.Get("access_key").(string),
 		SecretKey:               d.Get("secret_key").(string),
@@ -1021,6 +1098,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		SensitiveAttributeNames: providerSensitiveAttributeNames(provider),
 	}
 
 	// Set CredsFilename, expanding home directory
@@ -1030,23 +1108,30 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleARN = assumeRole["role_arn"].(string)
-		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
-		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
+	if v, ok := d.GetOk("assume_role"); ok {
+		config.AssumeRoles = expandProviderAssumeRoles(v.([]interface{}))
 
-		if v := assumeRole["policy"].(string); v != "" {
-			config.AssumeRolePolicy = v
+		for _, assumeRole := range config.AssumeRoles {
+			log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, PolicyARNs: %q, DurationSeconds: %d)",
+				assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID, assumeRole.Policy, assumeRole.PolicyARNs, assumeRole.DurationSeconds)
 		}
-
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
 	} else {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	if v, ok := d.GetOk("assume_role_with_web_identity"); ok {
+		config.AssumeRoleWithWebIdentity = expandProviderAssumeRoleWithWebIdentity(v.([]interface{}))
+
+		tokenPath, err := homedir.Expand(config.AssumeRoleWithWebIdentity.WebIdentityTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		config.AssumeRoleWithWebIdentity.WebIdentityTokenFile = tokenPath
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, WebIdentityTokenFile: %q)",
+			config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName, config.AssumeRoleWithWebIdentity.WebIdentityTokenFile)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1056,6 +1141,24 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	if v, ok := d.GetOk("api_rate_limits"); ok {
+		apiRateLimits, err := expandProviderApiRateLimits(v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, err
+		}
+
+		config.APIRateLimits = apiRateLimits
+	}
+
+	if v, ok := d.GetOk("default_tags"); ok {
+		config.DefaultTagsConfig = expandProviderDefaultTags(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok {
+		config.IgnoreTagsConfig = expandProviderIgnoreTags(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1071,14 +1174,61 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	return config.Client()
 }
 
+// providerSensitiveAttributeNames returns the names of all string and map
+// attributes marked Sensitive in the provider, resource and data source
+// schemas, so their values can be redacted from API request logs.
+func providerSensitiveAttributeNames(provider *schema.Provider) []string {
+	names := make(map[string]bool)
+
+	var walk func(map[string]*schema.Schema)
+	walk = func(m map[string]*schema.Schema) {
+		for k, v := range m {
+			if v.Sensitive && (v.Type == schema.TypeString || v.Type == schema.TypeMap) {
+				names[k] = true
+			}
+
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				walk(elem.Schema)
+			}
+		}
+	}
+
+	walk(provider.Schema)
+
+	for _, r := range provider.ResourcesMap {
+		walk(r.Schema)
+	}
+
+	for _, r := range provider.DataSourcesMap {
+		walk(r.Schema)
+	}
+
+	result := make([]string, 0, len(names))
+	for k := range names {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
 // This is a global MutexKV for use within this plugin.
 var awsMutexKV = mutexkv.NewMutexKV()
 
+// dataSourceRegionSchema returns the schema of the optional region argument
+// of data sources which can read from regions other than the provider's.
+func dataSourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -1104,11 +1254,264 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
+				},
+
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+					Description: descriptions["assume_role_policy_arns"],
+				},
 			},
 		},
 	}
 }
 
+func expandProviderAssumeRoles(l []interface{}) []*AssumeRole {
+	var assumeRoles []*AssumeRole
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// Roles without an ARN are skipped, as aws-sdk-go-base does, so
+		// role_arn can be set from a variable which may be empty
+		if m["role_arn"].(string) == "" {
+			continue
+		}
+
+		assumeRole := &AssumeRole{
+			RoleARN:         m["role_arn"].(string),
+			SessionName:     m["session_name"].(string),
+			ExternalID:      m["external_id"].(string),
+			Policy:          m["policy"].(string),
+			DurationSeconds: m["duration_seconds"].(int),
+		}
+
+		if v, ok := m["policy_arns"].(*schema.Set); ok {
+			for _, policyARN := range v.List() {
+				assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN.(string))
+			}
+		}
+
+		assumeRoles = append(assumeRoles, assumeRole)
+	}
+
+	return assumeRoles
+}
+
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+					Description:  descriptions["assume_role_with_web_identity_role_arn"],
+				},
+
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_with_web_identity_session_name"],
+				},
+
+				"web_identity_token_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_web_identity_web_identity_token_file"],
+				},
+			},
+		},
+	}
+}
+
+func expandProviderAssumeRoleWithWebIdentity(l []interface{}) *AssumeRoleWithWebIdentity {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &AssumeRoleWithWebIdentity{
+		RoleARN:              m["role_arn"].(string),
+		SessionName:          m["session_name"].(string),
+		WebIdentityTokenFile: m["web_identity_token_file"].(string),
+	}
+}
+
+func apiRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(apiRateLimitsServiceNames(), false),
+					Description:  descriptions["api_rate_limits_service"],
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatBetween(0.01, 10000),
+					Description:  descriptions["api_rate_limits_requests_per_second"],
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["api_rate_limits_burst"],
+				},
+			},
+		},
+	}
+}
+
+// apiRateLimitsServiceNames returns the names of the services which can be
+// rate limited, which are the endpoints configuration block names without
+// the deprecated aliases.
+func apiRateLimitsServiceNames() []string {
+	var names []string
+
+	for _, name := range endpointServiceNames {
+		if _, ok := deprecatedEndpointServiceNames[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func expandProviderApiRateLimits(l []interface{}) (map[string]ratelimit.Config, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	rateLimits := make(map[string]ratelimit.Config, len(l))
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := m["service"].(string)
+
+		if _, ok := rateLimits[service]; ok {
+			return nil, fmt.Errorf("api_rate_limits: service %q is configured more than once", service)
+		}
+
+		rateLimits[service] = ratelimit.Config{
+			RequestsPerSecond: m["requests_per_second"].(float64),
+			Burst:             m["burst"].(int),
+		}
+	}
+
+	return rateLimits, nil
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+func expandProviderDefaultTags(l []interface{}) *DefaultTagsConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &DefaultTagsConfig{
+		Tags: make(map[string]string),
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		for k, v := range v {
+			defaultConfig.Tags[k] = v.(string)
+		}
+	}
+
+	return defaultConfig
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+func expandProviderIgnoreTags(l []interface{}) *IgnoreTagsConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	ignoreConfig := &IgnoreTagsConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["keys"].(*schema.Set); ok {
+		for _, keyRaw := range v.List() {
+			ignoreConfig.Keys = append(ignoreConfig.Keys, keyRaw.(string))
+		}
+	}
+
+	if v, ok := m["key_prefixes"].(*schema.Set); ok {
+		for _, prefixRaw := range v.List() {
+			ignoreConfig.KeyPrefixes = append(ignoreConfig.KeyPrefixes, prefixRaw.(string))
+		}
+	}
+
+	return ignoreConfig
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1122,8 +1525,9 @@ func endpointsSchema() *schema.Schema {
 	}
 
 	// Since the endpoints attribute is a TypeSet we cannot use ConflictsWith
-	endpointsAttributes["kinesis_analytics"].Deprecated = "use `endpoints` configuration block `kinesisanalytics` argument instead"
-	endpointsAttributes["r53"].Deprecated = "use `endpoints` configuration block `route53` argument instead"
+	for deprecated, replacement := range deprecatedEndpointServiceNames {
+		endpointsAttributes[deprecated].Deprecated = fmt.Sprintf("use `endpoints` configuration block `%s` argument instead", replacement)
+	}
 
 	return &schema.Schema{
 		Type:     schema.TypeSet,
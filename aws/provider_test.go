@@ -6,6 +6,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
 	"github.com/terraform-providers/terraform-provider-template/template"
 	"github.com/terraform-providers/terraform-provider-tls/tls"
 )
@@ -65,6 +67,199 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestExpandProviderAssumeRoles(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected []*AssumeRole
+	}{
+		{
+			Name:     "no blocks",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "empty role_arn",
+			Input: []interface{}{
+				map[string]interface{}{
+					"role_arn":         "",
+					"session_name":     "",
+					"external_id":      "",
+					"policy":           "",
+					"duration_seconds": 0,
+				},
+			},
+			Expected: nil,
+		},
+		{
+			Name: "empty role_arn in chain",
+			Input: []interface{}{
+				map[string]interface{}{
+					"role_arn":         "arn:aws:iam::222222222222:role/hub",
+					"session_name":     "hub",
+					"external_id":      "",
+					"policy":           "",
+					"duration_seconds": 0,
+				},
+				map[string]interface{}{
+					"role_arn":         "",
+					"session_name":     "spoke",
+					"external_id":      "",
+					"policy":           "",
+					"duration_seconds": 0,
+				},
+			},
+			Expected: []*AssumeRole{
+				{
+					RoleARN:     "arn:aws:iam::222222222222:role/hub",
+					SessionName: "hub",
+				},
+			},
+		},
+		{
+			Name: "all arguments",
+			Input: []interface{}{
+				map[string]interface{}{
+					"role_arn":         "arn:aws:iam::333333333333:role/spoke",
+					"session_name":     "spoke",
+					"external_id":      "example",
+					"policy":           "{}",
+					"policy_arns":      schema.NewSet(schema.HashString, []interface{}{"arn:aws:iam::aws:policy/ReadOnlyAccess"}),
+					"duration_seconds": 1800,
+				},
+			},
+			Expected: []*AssumeRole{
+				{
+					RoleARN:         "arn:aws:iam::333333333333:role/spoke",
+					SessionName:     "spoke",
+					ExternalID:      "example",
+					Policy:          "{}",
+					PolicyARNs:      []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+					DurationSeconds: 1800,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := expandProviderAssumeRoles(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandProviderApiRateLimits(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         []interface{}
+		Expected      map[string]ratelimit.Config
+		ExpectedError string
+	}{
+		{
+			Name:     "no blocks",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "multiple services",
+			Input: []interface{}{
+				map[string]interface{}{
+					"service":             "ec2",
+					"requests_per_second": 10.0,
+					"burst":               20,
+				},
+				map[string]interface{}{
+					"service":             "elb",
+					"requests_per_second": 2.0,
+					"burst":               0,
+				},
+			},
+			Expected: map[string]ratelimit.Config{
+				"ec2": {RequestsPerSecond: 10, Burst: 20},
+				"elb": {RequestsPerSecond: 2},
+			},
+		},
+		{
+			Name: "duplicate service",
+			Input: []interface{}{
+				map[string]interface{}{
+					"service":             "ec2",
+					"requests_per_second": 10.0,
+					"burst":               0,
+				},
+				map[string]interface{}{
+					"service":             "ec2",
+					"requests_per_second": 5.0,
+					"burst":               0,
+				},
+			},
+			ExpectedError: `service "ec2" is configured more than once`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandProviderApiRateLimits(testCase.Input)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestApiRateLimitsServiceNames(t *testing.T) {
+	names := apiRateLimitsServiceNames()
+
+	for _, name := range names {
+		if _, ok := deprecatedEndpointServiceNames[name]; ok {
+			t.Errorf("expected deprecated endpoint name %q to be excluded", name)
+		}
+	}
+
+	if got, expected := len(names), len(endpointServiceNames)-len(deprecatedEndpointServiceNames); got != expected {
+		t.Errorf("expected %d service names, got %d", expected, got)
+	}
+}
+
+func TestProviderSensitiveAttributeNames(t *testing.T) {
+	names := providerSensitiveAttributeNames(Provider().(*schema.Provider))
+
+	for _, expected := range []string{"master_password", "password", "secret_key", "secret_string"} {
+		found := false
+		for _, name := range names {
+			if name == expected {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("expected sensitive attribute name %q in %v", expected, names)
+		}
+	}
+
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("expected sorted names, got %v", names)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if os.Getenv("AWS_PROFILE") == "" && os.Getenv("AWS_ACCESS_KEY_ID") == "" {
 		t.Fatal("AWS_ACCESS_KEY_ID or AWS_PROFILE must be set for acceptance tests")
@@ -144,6 +339,26 @@ func testAccMatchResourceAttrRegionalARN(resourceName, attributeName, arnService
 	}
 }
 
+// testAccMatchResourceAttrRegionalARNNoAccount ensures the Terraform state regexp matches a formatted ARN with region but without account ID
+func testAccMatchResourceAttrRegionalARNNoAccount(resourceName, attributeName, arnService string, arnResourceRegexp *regexp.Regexp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		arnRegexp := arn.ARN{
+			Partition: testAccGetPartition(),
+			Region:    testAccGetRegion(),
+			Resource:  arnResourceRegexp.String(),
+			Service:   arnService,
+		}.String()
+
+		attributeMatch, err := regexp.Compile(arnRegexp)
+
+		if err != nil {
+			return fmt.Errorf("Unable to compile ARN regexp (%s): %s", arnRegexp, err)
+		}
+
+		return resource.TestMatchResourceAttr(resourceName, attributeName, attributeMatch)(s)
+	}
+}
+
 // testAccCheckResourceAttrGlobalARN ensures the Terraform state exactly matches a formatted ARN without region
 func testAccCheckResourceAttrGlobalARN(resourceName, attributeName, arnService, arnResource string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -191,6 +406,21 @@ func testAccGetRegion() string {
 	return v
 }
 
+// testAccGetAlternateRegion returns a region other than the acceptance test
+// region, from AWS_ALTERNATE_REGION if set.
+func testAccGetAlternateRegion() string {
+	v := os.Getenv("AWS_ALTERNATE_REGION")
+	if v != "" {
+		return v
+	}
+
+	if testAccGetRegion() == "us-east-1" {
+		return "us-west-2"
+	}
+
+	return "us-east-1"
+}
+
 func testAccGetPartition() string {
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), testAccGetRegion()); ok {
 		return partition.ID()
@@ -448,6 +678,119 @@ func TestAccAWSProvider_Endpoints_Deprecated(t *testing.T) {
 	})
 }
 
+func TestAccAWSProvider_ApiRateLimits(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSProviderConfigApiRateLimits("EC2", 5),
+				ExpectError: regexp.MustCompile(`expected service to be one of`),
+			},
+			{
+				Config: testAccAWSProviderConfigApiRateLimits("ec2", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderApiRateLimits(&providers, "ec2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_DefaultTags_Tags(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTags1("test", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderDefaultTags(&providers, map[string]string{"test": "value"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_DefaultTags_EmptyConfigurationBlock(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTagsEmptyConfigurationBlock(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderDefaultTags(&providers, map[string]string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_IgnoreTags_Keys(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigIgnoreTagsKeys1("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderIgnoreTagsKeys(&providers, []string{"test"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_IgnoreTags_KeyPrefixes(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigIgnoreTagsKeyPrefixes1("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderIgnoreTagsKeyPrefixes(&providers, []string{"test"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_IgnoreTags_EmptyConfigurationBlock(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigIgnoreTagsEmptyConfigurationBlock(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderIgnoreTagsKeys(&providers, []string{}),
+					testAccCheckAWSProviderIgnoreTagsKeyPrefixes(&providers, []string{}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSProviderEndpoints(providers *[]*schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
@@ -581,6 +924,143 @@ func testAccCheckAWSProviderEndpointsDeprecated(providers *[]*schema.Provider) r
 	}
 }
 
+func testAccCheckAWSProviderApiRateLimits(providers *[]*schema.Provider, expectedService string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provider := range *providers {
+			if provider == nil || provider.Meta() == nil || provider.Meta().(*AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provider.Meta().(*AWSClient)
+
+			// Remove the rate limit handler from a copy to determine its presence
+			signHandlers := providerClient.ec2conn.Handlers.Copy().Sign
+			signHandlersLen := signHandlers.Len()
+			signHandlers.RemoveByName(ratelimit.WaitHandlerName)
+
+			if signHandlers.Len() == signHandlersLen {
+				return fmt.Errorf("expected %s API rate limit handler to be installed", expectedService)
+			}
+
+			signHandlers = providerClient.s3conn.Handlers.Copy().Sign
+			signHandlersLen = signHandlers.Len()
+			signHandlers.RemoveByName(ratelimit.WaitHandlerName)
+
+			if signHandlers.Len() != signHandlersLen {
+				return fmt.Errorf("expected no s3 API rate limit handler to be installed")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSProviderDefaultTags(providers *[]*schema.Provider, expectedTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provider := range *providers {
+			if provider == nil || provider.Meta() == nil || provider.Meta().(*AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provider.Meta().(*AWSClient)
+			defaultTagsConfig := providerClient.defaultTagsConfig
+
+			var actualTags map[string]string
+			if defaultTagsConfig != nil {
+				actualTags = defaultTagsConfig.Tags
+			}
+
+			if len(actualTags) != len(expectedTags) {
+				return fmt.Errorf("expected default_tags tags (%d) length, got: %d", len(expectedTags), len(actualTags))
+			}
+
+			for expectedKey, expectedValue := range expectedTags {
+				if actualValue, ok := actualTags[expectedKey]; !ok || actualValue != expectedValue {
+					return fmt.Errorf("expected default_tags tag (%s) value (%s), got: %v", expectedKey, expectedValue, actualTags)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSProviderIgnoreTagsKeys(providers *[]*schema.Provider, expectedKeys []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provider := range *providers {
+			if provider == nil || provider.Meta() == nil || provider.Meta().(*AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provider.Meta().(*AWSClient)
+			ignoreTagsConfig := providerClient.ignoreTagsConfig
+
+			var actualKeys []string
+			if ignoreTagsConfig != nil {
+				actualKeys = ignoreTagsConfig.Keys
+			}
+
+			if len(actualKeys) != len(expectedKeys) {
+				return fmt.Errorf("expected ignore_tags keys (%d) length, got: %d", len(expectedKeys), len(actualKeys))
+			}
+
+			for _, expectedKey := range expectedKeys {
+				if !ignoreTagsConfig.Ignored(expectedKey) {
+					return fmt.Errorf("expected ignore_tags keys (%s) to be ignored, got: %v", expectedKey, actualKeys)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSProviderIgnoreTagsKeyPrefixes(providers *[]*schema.Provider, expectedKeyPrefixes []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provider := range *providers {
+			if provider == nil || provider.Meta() == nil || provider.Meta().(*AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provider.Meta().(*AWSClient)
+			ignoreTagsConfig := providerClient.ignoreTagsConfig
+
+			var actualKeyPrefixes []string
+			if ignoreTagsConfig != nil {
+				actualKeyPrefixes = ignoreTagsConfig.KeyPrefixes
+			}
+
+			if len(actualKeyPrefixes) != len(expectedKeyPrefixes) {
+				return fmt.Errorf("expected ignore_tags key_prefixes (%d) length, got: %d", len(expectedKeyPrefixes), len(actualKeyPrefixes))
+			}
+
+			for _, expectedKeyPrefix := range expectedKeyPrefixes {
+				if !ignoreTagsConfig.Ignored(expectedKeyPrefix + "suffix") {
+					return fmt.Errorf("expected ignore_tags key_prefixes (%s) to be ignored, got: %v", expectedKeyPrefix, actualKeyPrefixes)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccAWSProviderConfigEndpoints(endpoints string) string {
 	return fmt.Sprintf(`
 provider "aws" {
@@ -600,3 +1080,122 @@ data "aws_arn" "test" {
 }
 `, endpoints)
 }
+
+func testAccAWSProviderConfigApiRateLimits(service string, requestsPerSecond float64) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  api_rate_limits {
+    service             = %[1]q
+    requests_per_second = %[2]g
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:aws:s3:::test"
+}
+`, service, requestsPerSecond)
+}
+
+func testAccAWSProviderConfigDefaultTagsEmptyConfigurationBlock() string {
+	return `
+provider "aws" {
+  default_tags {}
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:aws:s3:::test"
+}
+`
+}
+
+func testAccAWSProviderConfigDefaultTags1(tag1, value1 string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:aws:s3:::test"
+}
+`, tag1, value1)
+}
+
+func testAccAWSProviderConfigIgnoreTagsEmptyConfigurationBlock() string {
+	return `
+provider "aws" {
+  ignore_tags {}
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:aws:s3:::test"
+}
+`
+}
+
+func testAccAWSProviderConfigIgnoreTagsKeyPrefixes1(tagPrefix1 string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    key_prefixes = [%[1]q]
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:aws:s3:::test"
+}
+`, tagPrefix1)
+}
+
+func testAccAWSProviderConfigIgnoreTagsKeys1(tag1 string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    keys = [%[1]q]
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:aws:s3:::test"
+}
+`, tag1)
+}
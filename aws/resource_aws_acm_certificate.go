@@ -22,6 +22,7 @@ func resourceAwsAcmCertificate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"certificate_body": {
@@ -108,7 +109,8 @@ func resourceAwsAcmCertificate() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -136,7 +138,7 @@ func resourceAwsAcmCertificateCreateImported(d *schema.ResourceData, meta interf
 	}
 
 	d.SetId(*resp.CertificateArn)
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
 			Tags:           tagsFromMapACM(v.(map[string]interface{})),
@@ -174,7 +176,7 @@ func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta inter
 	}
 
 	d.SetId(*resp.CertificateArn)
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
 			Tags:           tagsFromMapACM(v.(map[string]interface{})),
@@ -237,7 +239,7 @@ func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) err
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("error listing tags for certificate (%s): %s", d.Id(), err))
 		}
-		if err := d.Set("tags", tagsToMapACM(tagResp.Tags)); err != nil {
+		if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapACM(tagResp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 			return resource.NonRetryableError(err)
 		}
 
@@ -266,7 +268,7 @@ func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsACM(acmconn, d)
 		if err != nil {
 			return err
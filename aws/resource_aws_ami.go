@@ -37,6 +37,7 @@ func resourceAwsAmi() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(AWSAMIRetryTimeout),
@@ -197,7 +198,8 @@ func resourceAwsAmi() *schema.Resource {
 				ForceNew: true,
 				Default:  "simple",
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -391,7 +393,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ebs_block_device", ebsBlockDevs)
 	d.Set("ephemeral_block_device", ephemeralBlockDevs)
 
-	d.Set("tags", tagsToMap(image.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(image.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -405,6 +407,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.Get("description").(string) != "" {
@@ -177,7 +177,8 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -189,6 +190,8 @@ func resourceAwsAmiCopy() *schema.Resource {
 		Read:   resourceAwsAmiRead,
 		Update: resourceAwsAmiUpdate,
 		Delete: resourceAwsAmiDelete,
+
+		CustomizeDiff: setTagsDiff,
 	}
 }
 
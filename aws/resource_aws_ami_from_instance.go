@@ -164,7 +164,8 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -176,6 +177,8 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 		Read:   resourceAwsAmiRead,
 		Update: resourceAwsAmiUpdate,
 		Delete: resourceAwsAmiDelete,
+
+		CustomizeDiff: setTagsDiff,
 	}
 }
 
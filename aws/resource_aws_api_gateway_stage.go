@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsApiGatewayStage() *schema.Resource {
@@ -34,6 +35,7 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"access_log_settings": {
@@ -103,7 +105,8 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"xray_tracing_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -148,7 +151,7 @@ func resourceAwsApiGatewayStageCreate(d *schema.ResourceData, meta interface{})
 		}
 		input.Variables = aws.StringMap(variables)
 	}
-	if vars, ok := d.GetOk("tags"); ok {
+	if vars, ok := d.GetOk("tags_all"); ok {
 		newMap := make(map[string]string, len(vars.(map[string]interface{})))
 		for k, v := range vars.(map[string]interface{}) {
 			newMap[k] = v.(string)
@@ -243,7 +246,7 @@ func resourceAwsApiGatewayStageRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("documentation_version", stage.DocumentationVersion)
 	d.Set("xray_tracing_enabled", stage.TracingEnabled)
 
-	if err := d.Set("tags", aws.StringValueMap(stage.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, keyvaluetags.ApigatewayKeyValueTags(stage.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -281,6 +284,7 @@ func resourceAwsApiGatewayStageUpdate(d *schema.ResourceData, meta interface{})
 		return tagErr
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	operations := make([]*apigateway.PatchOperation, 0)
 	waitForCache := false
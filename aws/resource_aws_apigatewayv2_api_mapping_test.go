@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2ApiMapping_basic(t *testing.T) {
+	var domainName string
+	var v apigatewayv2.GetApiMappingOutput
+	resourceName := "aws_apigatewayv2_api_mapping.test"
+	domainNameResourceName := "aws_apigatewayv2_domain_name.test"
+	stageResourceName := "aws_apigatewayv2_stage.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domain := fmt.Sprintf("tf-acc-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2ApiMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2ApiMappingConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName, &domainName, &v),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", domainNameResourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage", stageResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ApiMappingImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayV2ApiMapping_ApiMappingKey(t *testing.T) {
+	var domainName string
+	var v apigatewayv2.GetApiMappingOutput
+	resourceName := "aws_apigatewayv2_api_mapping.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domain := fmt.Sprintf("tf-acc-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2ApiMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2ApiMappingConfig_apiMappingKey(rName, domain, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName, &domainName, &v),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", "foo"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2ApiMappingConfig_apiMappingKey(rName, domain, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName, &domainName, &v),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ApiMappingImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2ApiMappingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_api_mapping" {
+			continue
+		}
+
+		_, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
+			ApiMappingId: aws.String(rs.Primary.ID),
+			DomainName:   aws.String(rs.Primary.Attributes["domain_name"]),
+		})
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 API mapping %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2ApiMappingExists(n string, vDomainName *string, v *apigatewayv2.GetApiMappingOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 API mapping ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+		domainName := aws.String(rs.Primary.Attributes["domain_name"])
+		resp, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
+			ApiMappingId: aws.String(rs.Primary.ID),
+			DomainName:   domainName,
+		})
+		if err != nil {
+			return err
+		}
+
+		*vDomainName = *domainName
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSAPIGatewayV2ApiMappingImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["domain_name"]), nil
+	}
+}
+
+func testAccAWSAPIGatewayV2ApiMappingConfig_base(rName, domainName string) string {
+	return testAccAWSAPIGatewayV2StageConfig_basic(rName) + testAccAWSAPIGatewayV2DomainNameConfig_basic(domainName)
+}
+
+func testAccAWSAPIGatewayV2ApiMappingConfig_basic(rName, domainName string) string {
+	return testAccAWSAPIGatewayV2ApiMappingConfig_base(rName, domainName) + `
+resource "aws_apigatewayv2_api_mapping" "test" {
+  api_id      = "${aws_apigatewayv2_api.test.id}"
+  domain_name = "${aws_apigatewayv2_domain_name.test.id}"
+  stage       = "${aws_apigatewayv2_stage.test.id}"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2ApiMappingConfig_apiMappingKey(rName, domainName, apiMappingKey string) string {
+	return testAccAWSAPIGatewayV2ApiMappingConfig_base(rName, domainName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_api_mapping" "test" {
+  api_id          = "${aws_apigatewayv2_api.test.id}"
+  domain_name     = "${aws_apigatewayv2_domain_name.test.id}"
+  stage           = "${aws_apigatewayv2_stage.test.id}"
+  api_mapping_key = %[1]q
+}
+`, apiMappingKey)
+}
@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsApiGatewayV2DomainName() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2DomainNameCreate,
+		Read:   resourceAwsApiGatewayV2DomainNameRead,
+		Update: resourceAwsApiGatewayV2DomainNameUpdate,
+		Delete: resourceAwsApiGatewayV2DomainNameDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_mapping_selection_expression": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"domain_name_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"endpoint_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								apigatewayv2.EndpointTypeRegional,
+							}, false),
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_policy": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								apigatewayv2.SecurityPolicyTls12,
+							}, false),
+						},
+						"target_domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2DomainNameCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	req := &apigatewayv2.CreateDomainNameInput{
+		DomainName:               aws.String(d.Get("domain_name").(string)),
+		DomainNameConfigurations: expandApiGatewayV2DomainNameConfiguration(d.Get("domain_name_configuration").([]interface{})),
+		Tags:                     keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().Apigatewayv2Tags(),
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 domain name: %s", req)
+	resp, err := conn.CreateDomainName(req)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 domain name: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.DomainName))
+
+	return resourceAwsApiGatewayV2DomainNameRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2DomainNameRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	resp, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 domain name (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 domain name (%s): %s", d.Id(), err)
+	}
+
+	d.Set("api_mapping_selection_expression", resp.ApiMappingSelectionExpression)
+	d.Set("arn", apiGatewayV2DomainNameArn(meta, d.Id()))
+	d.Set("domain_name", resp.DomainName)
+	if err := d.Set("domain_name_configuration", flattenApiGatewayV2DomainNameConfiguration(resp.DomainNameConfigurations)); err != nil {
+		return fmt.Errorf("error setting domain_name_configuration: %s", err)
+	}
+
+	tags := keyvaluetags.Apigatewayv2KeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig).Map()
+
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2DomainNameUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	if d.HasChange("domain_name_configuration") {
+		req := &apigatewayv2.UpdateDomainNameInput{
+			DomainName:               aws.String(d.Id()),
+			DomainNameConfigurations: expandApiGatewayV2DomainNameConfiguration(d.Get("domain_name_configuration").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating API Gateway v2 domain name: %s", req)
+		_, err := conn.UpdateDomainName(req)
+		if err != nil {
+			return fmt.Errorf("error updating API Gateway v2 domain name (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{apigatewayv2.DomainNameStatusUpdating},
+			Target:     []string{apigatewayv2.DomainNameStatusAvailable},
+			Refresh:    apiGatewayV2DomainNameRefreshStatusFunc(conn, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for API Gateway v2 domain name (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.Apigatewayv2UpdateTags(conn, apiGatewayV2DomainNameArn(meta, d.Id()), o, n); err != nil {
+			return fmt.Errorf("error updating API Gateway v2 domain name (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsApiGatewayV2DomainNameRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2DomainNameDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 domain name (%s)", d.Id())
+	_, err := conn.DeleteDomainName(&apigatewayv2.DeleteDomainNameInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 domain name (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func apiGatewayV2DomainNameArn(meta interface{}, domainName string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/domainnames/%s", domainName),
+	}.String()
+}
+
+func apiGatewayV2DomainNameRefreshStatusFunc(conn *apigatewayv2.ApiGatewayV2, domainName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+			DomainName: aws.String(domainName),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.DomainNameConfigurations) < 1 || resp.DomainNameConfigurations[0] == nil {
+			return resp, "", nil
+		}
+
+		return resp, aws.StringValue(resp.DomainNameConfigurations[0].DomainNameStatus), nil
+	}
+}
+
+func expandApiGatewayV2DomainNameConfiguration(vConfigurations []interface{}) []*apigatewayv2.DomainNameConfiguration {
+	configurations := []*apigatewayv2.DomainNameConfiguration{}
+
+	for _, vConfiguration := range vConfigurations {
+		mConfiguration, ok := vConfiguration.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configurations = append(configurations, &apigatewayv2.DomainNameConfiguration{
+			CertificateArn: aws.String(mConfiguration["certificate_arn"].(string)),
+			EndpointType:   aws.String(mConfiguration["endpoint_type"].(string)),
+			SecurityPolicy: aws.String(mConfiguration["security_policy"].(string)),
+		})
+	}
+
+	return configurations
+}
+
+func flattenApiGatewayV2DomainNameConfiguration(configurations []*apigatewayv2.DomainNameConfiguration) []interface{} {
+	vConfigurations := []interface{}{}
+
+	for _, configuration := range configurations {
+		if configuration == nil {
+			continue
+		}
+
+		vConfigurations = append(vConfigurations, map[string]interface{}{
+			"certificate_arn":    aws.StringValue(configuration.CertificateArn),
+			"endpoint_type":      aws.StringValue(configuration.EndpointType),
+			"hosted_zone_id":     aws.StringValue(configuration.HostedZoneId),
+			"security_policy":    aws.StringValue(configuration.SecurityPolicy),
+			"target_domain_name": aws.StringValue(configuration.ApiGatewayDomainName),
+		})
+	}
+
+	return vConfigurations
+}
@@ -20,6 +20,7 @@ func resourceAwsAppmeshMesh() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -75,7 +76,8 @@ func resourceAwsAppmeshMesh() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -87,7 +89,7 @@ func resourceAwsAppmeshMeshCreate(d *schema.ResourceData, meta interface{}) erro
 	req := &appmesh.CreateMeshInput{
 		MeshName: aws.String(meshName),
 		Spec:     expandAppmeshMeshSpec(d.Get("spec").([]interface{})),
-		Tags:     tagsFromMapAppmesh(d.Get("tags").(map[string]interface{})),
+		Tags:     tagsFromMapAppmesh(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Printf("[DEBUG] Creating App Mesh service mesh: %#v", req)
@@ -130,7 +132,7 @@ func resourceAwsAppmeshMeshRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting spec: %s", err)
 	}
 
-	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.Mesh.Metadata.Arn))
+	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.Mesh.Metadata.Arn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] App Mesh service mesh (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -24,6 +24,7 @@ func resourceAwsAppmeshRoute() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsAppmeshRouteImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -176,7 +177,8 @@ func resourceAwsAppmeshRoute() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -189,7 +191,7 @@ func resourceAwsAppmeshRouteCreate(d *schema.ResourceData, meta interface{}) err
 		RouteName:         aws.String(d.Get("name").(string)),
 		VirtualRouterName: aws.String(d.Get("virtual_router_name").(string)),
 		Spec:              expandAppmeshRouteSpec(d.Get("spec").([]interface{})),
-		Tags:              tagsFromMapAppmesh(d.Get("tags").(map[string]interface{})),
+		Tags:              tagsFromMapAppmesh(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Printf("[DEBUG] Creating App Mesh route: %#v", req)
@@ -236,7 +238,7 @@ func resourceAwsAppmeshRouteRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting spec: %s", err)
 	}
 
-	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.Route.Metadata.Arn))
+	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.Route.Metadata.Arn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] App Mesh route (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -23,6 +23,7 @@ func resourceAwsAppmeshVirtualNode() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsAppmeshVirtualNodeImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsAppmeshVirtualNodeMigrateState,
@@ -291,7 +292,8 @@ func resourceAwsAppmeshVirtualNode() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -303,7 +305,7 @@ func resourceAwsAppmeshVirtualNodeCreate(d *schema.ResourceData, meta interface{
 		MeshName:        aws.String(d.Get("mesh_name").(string)),
 		VirtualNodeName: aws.String(d.Get("name").(string)),
 		Spec:            expandAppmeshVirtualNodeSpec(d.Get("spec").([]interface{})),
-		Tags:            tagsFromMapAppmesh(d.Get("tags").(map[string]interface{})),
+		Tags:            tagsFromMapAppmesh(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Printf("[DEBUG] Creating App Mesh virtual node: %#v", req)
@@ -348,7 +350,7 @@ func resourceAwsAppmeshVirtualNodeRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting spec: %s", err)
 	}
 
-	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.VirtualNode.Metadata.Arn))
+	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.VirtualNode.Metadata.Arn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] App Mesh virtual node (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -23,6 +23,7 @@ func resourceAwsAppmeshVirtualRouter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsAppmeshVirtualRouterImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsAppmeshVirtualRouterMigrateState,
@@ -111,7 +112,8 @@ func resourceAwsAppmeshVirtualRouter() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -123,7 +125,7 @@ func resourceAwsAppmeshVirtualRouterCreate(d *schema.ResourceData, meta interfac
 		MeshName:          aws.String(d.Get("mesh_name").(string)),
 		VirtualRouterName: aws.String(d.Get("name").(string)),
 		Spec:              expandAppmeshVirtualRouterSpec(d.Get("spec").([]interface{})),
-		Tags:              tagsFromMapAppmesh(d.Get("tags").(map[string]interface{})),
+		Tags:              tagsFromMapAppmesh(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Printf("[DEBUG] Creating App Mesh virtual router: %#v", req)
@@ -168,7 +170,7 @@ func resourceAwsAppmeshVirtualRouterRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("error setting spec: %s", err)
 	}
 
-	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.VirtualRouter.Metadata.Arn))
+	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.VirtualRouter.Metadata.Arn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] App Mesh virtual router (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -21,6 +21,7 @@ func resourceAwsAppmeshVirtualService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsAppmeshVirtualServiceImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -106,7 +107,8 @@ func resourceAwsAppmeshVirtualService() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -118,7 +120,7 @@ func resourceAwsAppmeshVirtualServiceCreate(d *schema.ResourceData, meta interfa
 		MeshName:           aws.String(d.Get("mesh_name").(string)),
 		VirtualServiceName: aws.String(d.Get("name").(string)),
 		Spec:               expandAppmeshVirtualServiceSpec(d.Get("spec").([]interface{})),
-		Tags:               tagsFromMapAppmesh(d.Get("tags").(map[string]interface{})),
+		Tags:               tagsFromMapAppmesh(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Printf("[DEBUG] Creating App Mesh virtual service: %#v", req)
@@ -163,7 +165,7 @@ func resourceAwsAppmeshVirtualServiceRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error setting spec: %s", err)
 	}
 
-	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.VirtualService.Metadata.Arn))
+	err = saveTagsAppmesh(conn, d, aws.StringValue(resp.VirtualService.Metadata.Arn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] App Mesh virtual service (%s) not found, removing from state", d.Id())
 		d.SetId("")
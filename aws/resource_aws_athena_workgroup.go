@@ -21,6 +21,7 @@ func resourceAwsAthenaWorkgroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -114,7 +115,8 @@ func resourceAwsAthenaWorkgroup() *schema.Resource {
 					athena.WorkGroupStateEnabled,
 				}, false),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -135,7 +137,7 @@ func resourceAwsAthenaWorkgroupCreate(d *schema.ResourceData, meta interface{})
 
 	// Prevent the below error:
 	// InvalidRequestException: Tags provided upon WorkGroup creation must not be empty
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
 		input.Tags = tagsFromMapAthena(v)
 	}
 
@@ -198,7 +200,7 @@ func resourceAwsAthenaWorkgroupRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", resp.WorkGroup.Name)
 	d.Set("state", resp.WorkGroup.State)
 
-	err = saveTagsAthena(conn, d, d.Get("arn").(string))
+	err = saveTagsAthena(conn, d, d.Get("arn").(string), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 
 	if isAWSErr(err, athena.ErrCodeInvalidRequestException, "is not found") {
 		log.Printf("[WARN] Athena WorkGroup (%s) not found, removing from state", d.Id())
@@ -261,7 +263,7 @@ func resourceAwsAthenaWorkgroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsAthena(conn, d, d.Get("arn").(string))
 
 		if err != nil {
@@ -701,7 +701,7 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 				tagList = append(tagList, t)
 			}
 		}
-		d.Set("tag", autoscalingTagDescriptionsToSlice(tagList))
+		d.Set("tag", autoscalingTagDescriptionsToSlice(tagList, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	if v, tagsOk = d.GetOk("tags"); tagsOk {
@@ -725,11 +725,11 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 				tagsList = append(tagsList, t)
 			}
 		}
-		d.Set("tags", autoscalingTagDescriptionsToSlice(tagsList))
+		d.Set("tags", autoscalingTagDescriptionsToSlice(tagsList, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	if !tagOk && !tagsOk {
-		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags))
+		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	if err := d.Set("target_group_arns", flattenStringList(g.TargetGroupARNs)); err != nil {
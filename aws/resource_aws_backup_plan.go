@@ -9,14 +9,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsBackupPlan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsBackupPlanCreate,
-		Read:   resourceAwsBackupPlanRead,
-		Update: resourceAwsBackupPlanUpdate,
-		Delete: resourceAwsBackupPlanDelete,
+		Create:        resourceAwsBackupPlanCreate,
+		Read:          resourceAwsBackupPlanRead,
+		Update:        resourceAwsBackupPlanUpdate,
+		Delete:        resourceAwsBackupPlanDelete,
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -85,7 +87,8 @@ func resourceAwsBackupPlan() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -105,7 +108,7 @@ func resourceAwsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error
 		BackupPlan: plan,
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.BackupPlanTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
@@ -169,7 +172,7 @@ func resourceAwsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing tags AWS Backup plan %s: %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapGeneric(tagsOutput.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapGeneric(tagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags on AWS Backup plan %s: %s", d.Id(), err)
 	}
 
@@ -200,21 +203,19 @@ func resourceAwsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error updating Backup Plan: %s", err)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		resourceArn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
+		o, n := d.GetChange("tags_all")
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		create := oldTags.Updated(newTags).IgnoreAws()
+		remove := oldTags.Removed(newTags).IgnoreAws()
 
 		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
-
+			log.Printf("[DEBUG] Removing tags: %s", remove.Keys())
 			_, err := conn.UntagResource(&backup.UntagResourceInput{
 				ResourceArn: aws.String(resourceArn),
-				TagKeyList:  keys,
+				TagKeyList:  aws.StringSlice(remove.Keys()),
 			})
 			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
 				log.Printf("[WARN] Backup Plan %s not found, removing from state", d.Id())
@@ -226,10 +227,10 @@ func resourceAwsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+			log.Printf("[DEBUG] Creating tags: %s", create.Keys())
 			_, err := conn.TagResource(&backup.TagResourceInput{
 				ResourceArn: aws.String(resourceArn),
-				Tags:        create,
+				Tags:        create.BackupTags(),
 			})
 			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
 				log.Printf("[WARN] Backup Plan %s not found, removing from state", d.Id())
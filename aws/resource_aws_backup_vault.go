@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsBackupVault() *schema.Resource {
@@ -107,7 +108,7 @@ func resourceAwsBackupVaultRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error retrieving Backup Vault (%s) tags: %s", aws.StringValue(resp.BackupVaultArn), err)
 	}
 
-	if err := d.Set("tags", tagsToMapGeneric(tresp.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMapGeneric(tresp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -119,19 +120,17 @@ func resourceAwsBackupVaultUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if d.HasChange("tags") {
 		resourceArn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
+		o, n := d.GetChange("tags")
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		create := oldTags.Updated(newTags).IgnoreAws()
+		remove := oldTags.Removed(newTags).IgnoreAws()
 
 		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
-
+			log.Printf("[DEBUG] Removing tags: %s", remove.Keys())
 			_, err := conn.UntagResource(&backup.UntagResourceInput{
 				ResourceArn: aws.String(resourceArn),
-				TagKeyList:  keys,
+				TagKeyList:  aws.StringSlice(remove.Keys()),
 			})
 			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
 				log.Printf("[WARN] Backup Vault %s not found, removing from state", d.Id())
@@ -143,10 +142,10 @@ func resourceAwsBackupVaultUpdate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+			log.Printf("[DEBUG] Creating tags: %s", create.Keys())
 			_, err := conn.TagResource(&backup.TagResourceInput{
 				ResourceArn: aws.String(resourceArn),
-				Tags:        create,
+				Tags:        create.BackupTags(),
 			})
 			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
 				log.Printf("[WARN] Backup Vault %s not found, removing from state", d.Id())
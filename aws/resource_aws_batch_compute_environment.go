@@ -333,7 +333,7 @@ func flattenBatchComputeResources(computeResource *batch.ComputeResource) []map[
 	m["security_group_ids"] = schema.NewSet(schema.HashString, flattenStringList(computeResource.SecurityGroupIds))
 	m["spot_iam_fleet_role"] = aws.StringValue(computeResource.SpotIamFleetRole)
 	m["subnets"] = schema.NewSet(schema.HashString, flattenStringList(computeResource.Subnets))
-	m["tags"] = tagsToMapGeneric(computeResource.Tags)
+	m["tags"] = tagsToMapGeneric(computeResource.Tags, nil)
 	m["type"] = aws.StringValue(computeResource.Type)
 
 	if launchTemplate := computeResource.LaunchTemplate; launchTemplate != nil {
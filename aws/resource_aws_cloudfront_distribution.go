@@ -22,6 +22,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsCloudFrontDistributionImport,
 		},
+		CustomizeDiff: setTagsDiff,
 		MigrateState:  resourceAwsCloudFrontDistributionMigrateState,
 		SchemaVersion: 1,
 
@@ -737,7 +738,8 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -748,7 +750,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
 			DistributionConfig: expandDistributionConfig(d),
-			Tags:               tagsFromMapCloudFront(d.Get("tags").(map[string]interface{})),
+			Tags:               tagsFromMapCloudFront(d.Get("tags_all").(map[string]interface{})),
 		},
 	}
 
@@ -836,7 +838,7 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 			d.Id(), d.Get("arn").(string), err)
 	}
 
-	if err := d.Set("tags", tagsToMapCloudFront(tagResp.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapCloudFront(tagResp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -2,15 +2,17 @@ package aws
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform/helper/validation"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/validation"
+
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCloudHsm2Cluster() *schema.Resource {
@@ -22,6 +24,7 @@ func resourceAwsCloudHsm2Cluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -101,7 +104,8 @@ func resourceAwsCloudHsm2Cluster() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -302,42 +306,10 @@ func resourceAwsCloudHsm2ClusterDelete(d *schema.ResourceData, meta interface{})
 }
 
 func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.UntagResource(&cloudhsmv2.UntagResourceInput{
-				ResourceId: aws.String(d.Id()),
-				TagKeyList: keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			tagList := make([]*cloudhsmv2.Tag, 0, len(create))
-			for k, v := range create {
-				tagList = append(tagList, &cloudhsmv2.Tag{
-					Key:   &k,
-					Value: v,
-				})
-			}
-			_, err := conn.TagResource(&cloudhsmv2.TagResourceInput{
-				ResourceId: aws.String(d.Id()),
-				TagList:    tagList,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.Cloudhsmv2UpdateTags(conn, d.Id(), o, n)
 	}
 
 	return nil
@@ -21,6 +21,7 @@ func resourceAwsCloudTrail() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -131,7 +132,8 @@ func resourceAwsCloudTrail() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -279,7 +281,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 		tags = tagsOut.ResourceTagList[0].TagsList
 	}
 
-	if err := d.Set("tags", tagsToMapCloudtrail(tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapCloudtrail(tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -362,7 +364,7 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsCloudtrail(conn, d)
 		if err != nil {
 			return err
@@ -23,6 +23,7 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -72,7 +73,8 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -168,7 +170,7 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 	}
 	log.Printf("[DEBUG] Setting boolean state: %t", boolState)
 	d.Set("is_enabled", boolState)
-	if err := saveTagsCloudWatchEvents(conn, d, aws.StringValue(out.Arn)); err != nil {
+	if err := saveTagsCloudWatchEvents(conn, d, aws.StringValue(out.Arn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 	return nil
@@ -226,7 +228,7 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG] CloudWatch Event Rule (%q) disabled", d.Id())
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsCloudWatchEvents(conn, d, d.Get("arn").(string)); err != nil {
 			return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCloudWatchLogGroup() *schema.Resource {
@@ -21,6 +22,7 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -54,7 +56,8 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -125,9 +128,9 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error listing CloudWatch Logs Group %q tags: %s", d.Id(), err)
 	}
 	if tagsOutput != nil {
-		tags = aws.StringValueMap(tagsOutput.Tags)
+		tags = keyvaluetags.CloudwatchlogsKeyValueTags(tagsOutput.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig).Map()
 	}
-	d.Set("tags", tags)
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags)
 
 	return nil
 }
@@ -181,8 +184,8 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffCloudWatchTags(o, n)
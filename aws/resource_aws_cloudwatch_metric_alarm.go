@@ -3,11 +3,13 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
@@ -23,6 +25,10 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			resourceAwsCloudWatchMetricAlarmCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"alarm_name": {
@@ -123,8 +129,15 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 				ConflictsWith: []string{"extended_statistic", "metric_query"},
 			},
 			"threshold": {
-				Type:     schema.TypeFloat,
-				Required: true,
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				ConflictsWith: []string{"threshold_metric_id"},
+			},
+			"threshold_metric_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"threshold"},
+				ValidateFunc:  validation.StringLenBetween(1, 255),
 			},
 			"actions_enabled": {
 				Type:     schema.TypeBool,
@@ -191,7 +204,8 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"evaluate", "ignore"}, true),
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -221,6 +235,59 @@ func validateResourceAwsCloudWatchMetricAlarm(d *schema.ResourceData) error {
 	return nil
 }
 
+// resourceAwsCloudWatchMetricAlarmCustomizeDiff checks that exactly one of
+// threshold or threshold_metric_id is set and that threshold_metric_id refers
+// to an anomaly detection band, so invalid alarms fail at plan time.
+func resourceAwsCloudWatchMetricAlarmCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("threshold") || !diff.NewValueKnown("threshold_metric_id") {
+		return nil
+	}
+
+	_, thresholdOk := diff.GetOkExists("threshold")
+	thresholdMetricId, thresholdMetricIdOk := diff.GetOk("threshold_metric_id")
+
+	if thresholdOk == thresholdMetricIdOk {
+		return fmt.Errorf("Exactly one of `threshold` or `threshold_metric_id` must be set for a cloudwatch metric alarm")
+	}
+
+	if !thresholdMetricIdOk || !diff.NewValueKnown("comparison_operator") || !diff.NewValueKnown("metric_query") {
+		return nil
+	}
+
+	return validateCloudWatchMetricAlarmThresholdMetricId(thresholdMetricId.(string), diff.Get("comparison_operator").(string), diff.Get("metric_query").(*schema.Set).List())
+}
+
+// validateCloudWatchMetricAlarmThresholdMetricId checks that threshold_metric_id
+// refers to a metric_query with an ANOMALY_DETECTION_BAND expression and that
+// the comparison operator compares against a band.
+func validateCloudWatchMetricAlarmThresholdMetricId(id, comparisonOperator string, metricQueries []interface{}) error {
+	switch comparisonOperator {
+	case cloudwatch.ComparisonOperatorLessThanLowerOrGreaterThanUpperThreshold,
+		cloudwatch.ComparisonOperatorLessThanLowerThreshold,
+		cloudwatch.ComparisonOperatorGreaterThanUpperThreshold:
+	default:
+		return fmt.Errorf("`comparison_operator` must be one of %s, %s or %s when `threshold_metric_id` is set",
+			cloudwatch.ComparisonOperatorLessThanLowerOrGreaterThanUpperThreshold,
+			cloudwatch.ComparisonOperatorLessThanLowerThreshold,
+			cloudwatch.ComparisonOperatorGreaterThanUpperThreshold)
+	}
+
+	for _, v := range metricQueries {
+		metricQueryResource := v.(map[string]interface{})
+		if metricQueryResource["id"].(string) != id {
+			continue
+		}
+
+		if !strings.HasPrefix(strings.TrimSpace(metricQueryResource["expression"].(string)), "ANOMALY_DETECTION_BAND(") {
+			return fmt.Errorf("metric_query %q referenced by `threshold_metric_id` must have an ANOMALY_DETECTION_BAND expression", id)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("`threshold_metric_id` %q does not match the id of any metric_query", id)
+}
+
 func resourceAwsCloudWatchMetricAlarmCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchconn
 
@@ -307,12 +374,13 @@ func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface
 	d.Set("period", a.Period)
 	d.Set("statistic", a.Statistic)
 	d.Set("threshold", a.Threshold)
+	d.Set("threshold_metric_id", a.ThresholdMetricId)
 	d.Set("unit", a.Unit)
 	d.Set("extended_statistic", a.ExtendedStatistic)
 	d.Set("treat_missing_data", a.TreatMissingData)
 	d.Set("evaluate_low_sample_count_percentiles", a.EvaluateLowSampleCountPercentile)
 
-	if err := saveTagsCloudWatch(meta.(*AWSClient).cloudwatchconn, d, aws.StringValue(a.AlarmArn)); err != nil {
+	if err := saveTagsCloudWatch(meta.(*AWSClient).cloudwatchconn, d, aws.StringValue(a.AlarmArn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -368,9 +436,14 @@ func getAwsCloudWatchPutMetricAlarmInput(d *schema.ResourceData) cloudwatch.PutM
 		AlarmName:          aws.String(d.Get("alarm_name").(string)),
 		ComparisonOperator: aws.String(d.Get("comparison_operator").(string)),
 		EvaluationPeriods:  aws.Int64(int64(d.Get("evaluation_periods").(int))),
-		Threshold:          aws.Float64(d.Get("threshold").(float64)),
 		TreatMissingData:   aws.String(d.Get("treat_missing_data").(string)),
-		Tags:               tagsFromMapCloudWatch(d.Get("tags").(map[string]interface{})),
+		Tags:               tagsFromMapCloudWatch(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("threshold_metric_id"); ok {
+		params.ThresholdMetricId = aws.String(v.(string))
+	} else {
+		params.Threshold = aws.Float64(d.Get("threshold").(float64))
 	}
 
 	if v := d.Get("actions_enabled"); v != nil {
@@ -286,6 +286,99 @@ func TestAccAWSCloudWatchMetricAlarm_expression(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchMetricAlarm_anomalyDetectionExpression(t *testing.T) {
+	var alarm cloudwatch.MetricAlarm
+	rInt := acctest.RandInt()
+	resourceName := "aws_cloudwatch_metric_alarm.foobar"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudWatchMetricAlarmConfigAnomalyDetectionExpression(rInt, "m1"),
+				ExpectError: regexp.MustCompile(`metric_query "m1" referenced by .threshold_metric_id. must have an ANOMALY_DETECTION_BAND expression`),
+			},
+			{
+				Config: testAccAWSCloudWatchMetricAlarmConfigAnomalyDetectionExpression(rInt, "e1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchMetricAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "threshold_metric_id", "e1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_query.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccAWSCloudWatchMetricAlarmConfigAnomalyDetectionExpression(rInt, "m1"),
+				ExpectError: regexp.MustCompile(`metric_query "m1" referenced by .threshold_metric_id. must have an ANOMALY_DETECTION_BAND expression`),
+			},
+		},
+	})
+}
+
+func TestValidateCloudWatchMetricAlarmThresholdMetricId(t *testing.T) {
+	metricQueries := []interface{}{
+		map[string]interface{}{
+			"id":         "m1",
+			"expression": "",
+		},
+		map[string]interface{}{
+			"id":         "e1",
+			"expression": "ANOMALY_DETECTION_BAND(m1, 2)",
+		},
+	}
+
+	cases := []struct {
+		id                 string
+		comparisonOperator string
+		errorRegexp        string
+	}{
+		{
+			id:                 "e1",
+			comparisonOperator: cloudwatch.ComparisonOperatorLessThanLowerOrGreaterThanUpperThreshold,
+		},
+		{
+			id:                 "e1",
+			comparisonOperator: cloudwatch.ComparisonOperatorGreaterThanUpperThreshold,
+		},
+		{
+			id:                 "e1",
+			comparisonOperator: cloudwatch.ComparisonOperatorGreaterThanOrEqualToThreshold,
+			errorRegexp:        "`comparison_operator` must be one of",
+		},
+		{
+			id:                 "m1",
+			comparisonOperator: cloudwatch.ComparisonOperatorLessThanLowerThreshold,
+			errorRegexp:        "must have an ANOMALY_DETECTION_BAND expression",
+		},
+		{
+			id:                 "e2",
+			comparisonOperator: cloudwatch.ComparisonOperatorLessThanLowerThreshold,
+			errorRegexp:        "does not match the id of any metric_query",
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateCloudWatchMetricAlarmThresholdMetricId(tc.id, tc.comparisonOperator, metricQueries)
+
+		if tc.errorRegexp == "" {
+			if err != nil {
+				t.Errorf("%s/%s: unexpected error: %s", tc.id, tc.comparisonOperator, err)
+			}
+			continue
+		}
+
+		if err == nil || !regexp.MustCompile(tc.errorRegexp).MatchString(err.Error()) {
+			t.Errorf("%s/%s: expected error matching %q, got: %v", tc.id, tc.comparisonOperator, tc.errorRegexp, err)
+		}
+	}
+}
+
 func TestAccAWSCloudWatchMetricAlarm_missingStatistic(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.ParallelTest(t, resource.TestCase{
@@ -301,6 +394,21 @@ func TestAccAWSCloudWatchMetricAlarm_missingStatistic(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchMetricAlarm_missingThreshold(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudWatchMetricAlarmConfigMissingThreshold(rInt),
+				ExpectError: regexp.MustCompile("Exactly one of `threshold` or `threshold_metric_id` must be set for a cloudwatch metric alarm"),
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudWatchMetricAlarm_tags(t *testing.T) {
 	var alarm cloudwatch.MetricAlarm
 	resourceName := "aws_cloudwatch_metric_alarm.foobar"
@@ -589,6 +697,26 @@ resource "aws_cloudwatch_metric_alarm" "foobar" {
 `, rInt)
 }
 
+func testAccAWSCloudWatchMetricAlarmConfigMissingThreshold(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "foobar" {
+  alarm_name                = "terraform-test-foobar%d"
+  comparison_operator       = "GreaterThanOrEqualToThreshold"
+  evaluation_periods        = "2"
+  metric_name               = "CPUUtilization"
+  namespace                 = "AWS/EC2"
+  period                    = "120"
+  statistic                 = "Average"
+  alarm_description         = "This metric monitors ec2 cpu utilization"
+  insufficient_data_actions = []
+
+  dimensions = {
+    InstanceId = "i-abc123"
+  }
+}
+`, rInt)
+}
+
 func testAccAWSCloudWatchMetricAlarmConfigWithExpression(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_metric_alarm" "foobar" {
@@ -703,6 +831,43 @@ resource "aws_cloudwatch_metric_alarm" "foobar" {
 `, rInt)
 }
 
+func testAccAWSCloudWatchMetricAlarmConfigAnomalyDetectionExpression(rInt int, thresholdMetricId string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "foobar" {
+  alarm_name                = "terraform-test-foobar%d"
+  comparison_operator       = "GreaterThanUpperThreshold"
+  evaluation_periods        = "2"
+  threshold_metric_id       = %q
+  alarm_description         = "This metric monitors ec2 cpu utilization"
+  insufficient_data_actions = []
+
+  metric_query {
+    id          = "e1"
+    expression  = "ANOMALY_DETECTION_BAND(m1)"
+    label       = "CPUUtilization (Expected)"
+    return_data = "true"
+  }
+
+  metric_query {
+    id          = "m1"
+    return_data = "true"
+
+    metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+      period      = "120"
+      stat        = "Average"
+      unit        = "Count"
+
+      dimensions = {
+        InstanceId = "i-abc123"
+      }
+    }
+  }
+}
+`, rInt, thresholdMetricId)
+}
+
 func testAccAWSCloudWatchMetricAlarmConfigWithBadExpression(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_metric_alarm" "foobar" {
@@ -19,6 +19,7 @@ func resourceAwsCodeCommitRepository() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"repository_name": {
@@ -58,7 +59,8 @@ func resourceAwsCodeCommitRepository() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -69,7 +71,7 @@ func resourceAwsCodeCommitRepositoryCreate(d *schema.ResourceData, meta interfac
 	input := &codecommit.CreateRepositoryInput{
 		RepositoryName:        aws.String(d.Get("repository_name").(string)),
 		RepositoryDescription: aws.String(d.Get("description").(string)),
-		Tags:                  tagsFromMapCodeCommit(d.Get("tags").(map[string]interface{})),
+		Tags:                  tagsFromMapCodeCommit(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	out, err := conn.CreateRepository(input)
@@ -149,7 +151,7 @@ func resourceAwsCodeCommitRepositoryRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return fmt.Errorf("error listing CodeCommit Repository tags for %s: %s", d.Id(), err)
 	}
-	if err := d.Set("tags", tagsToMapCodeCommit(tagList.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapCodeCommit(tagList.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -23,6 +23,7 @@ func resourceAwsCodePipeline() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -161,7 +162,8 @@ func resourceAwsCodePipeline() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -170,7 +172,7 @@ func resourceAwsCodePipelineCreate(d *schema.ResourceData, meta interface{}) err
 	conn := meta.(*AWSClient).codepipelineconn
 	params := &codepipeline.CreatePipelineInput{
 		Pipeline: expandAwsCodePipeline(d),
-		Tags:     tagsFromMapCodePipeline(d.Get("tags").(map[string]interface{})),
+		Tags:     tagsFromMapCodePipeline(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	var resp *codepipeline.CreatePipelineOutput
@@ -450,7 +452,7 @@ func resourceAwsCodePipelineRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", pipeline.Name)
 	d.Set("role_arn", pipeline.RoleArn)
 
-	if err := saveTagsCodePipeline(conn, d); err != nil {
+	if err := saveTagsCodePipeline(conn, d, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return err
 	}
 
@@ -271,7 +271,7 @@ func resourceAwsCodePipelineWebhookRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting filter: %s", err)
 	}
 
-	if err := d.Set("tags", tagsToMapCodePipeline(webhook.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMapCodePipeline(webhook.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -25,6 +25,7 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateUserPool.html
 		Schema: map[string]*schema.Schema{
@@ -414,7 +415,8 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				ConflictsWith: []string{"verification_message_template.0.sms_message"},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"username_attributes": {
 				Type:     schema.TypeList,
@@ -651,7 +653,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
@@ -781,7 +783,7 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("creation_date", resp.UserPool.CreationDate.Format(time.RFC3339))
 	d.Set("last_modified_date", resp.UserPool.LastModifiedDate.Format(time.RFC3339))
 	d.Set("name", resp.UserPool.Name)
-	d.Set("tags", tagsToMapGeneric(resp.UserPool.UserPoolTags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapGeneric(resp.UserPool.UserPoolTags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -922,7 +924,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
@@ -23,6 +23,7 @@ func resourceAwsCustomerGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"bgp_asn": {
@@ -43,7 +44,8 @@ func resourceAwsCustomerGateway() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -201,7 +203,7 @@ func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) er
 	customerGateway := resp.CustomerGateways[0]
 	d.Set("ip_address", customerGateway.IpAddress)
 	d.Set("type", customerGateway.Type)
-	d.Set("tags", tagsToMap(customerGateway.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(customerGateway.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	if *customerGateway.BgpAsn != "" {
 		val, err := strconv.ParseInt(*customerGateway.BgpAsn, 0, 0)
@@ -225,6 +227,8 @@ func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetPartial("tags")
 
+	d.SetPartial("tags_all")
+
 	return resourceAwsCustomerGatewayRead(d, meta)
 }
 
@@ -20,6 +20,7 @@ func resourceAwsDataPipelinePipeline() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -34,7 +35,8 @@ func resourceAwsDataPipelinePipeline() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -47,7 +49,7 @@ func resourceAwsDataPipelinePipelineCreate(d *schema.ResourceData, meta interfac
 	input := datapipeline.CreatePipelineInput{
 		Name:     aws.String(d.Get("name").(string)),
 		UniqueId: aws.String(uniqueID),
-		Tags:     tagsFromMapDataPipeline(d.Get("tags").(map[string]interface{})),
+		Tags:     tagsFromMapDataPipeline(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -80,7 +82,7 @@ func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{
 
 	d.Set("name", v.Name)
 	d.Set("description", v.Description)
-	if err := d.Set("tags", tagsToMapDataPipeline(v.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapDataPipeline(v.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 	return nil
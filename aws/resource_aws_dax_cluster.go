@@ -22,6 +22,7 @@ func resourceAwsDaxCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -122,7 +123,8 @@ func resourceAwsDaxCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"port": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -174,7 +176,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	securityIdSet := d.Get("security_group_ids").(*schema.Set)
 
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapDax(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapDax(d.Get("tags_all").(map[string]interface{}))
 
 	req := &dax.CreateClusterInput{
 		ClusterName:       aws.String(clusterName),
@@ -331,7 +333,7 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 	if len(resp.Tags) > 0 {
 		dt = resp.Tags
 	}
-	d.Set("tags", tagsToMapDax(dt))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapDax(dt, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -20,6 +20,7 @@ func resourceAwsDbEventSubscription() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDbEventSubscriptionImport,
 		},
+		CustomizeDiff: setTagsDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
 			Delete: schema.DefaultTimeout(40 * time.Minute),
@@ -76,7 +77,8 @@ func resourceAwsDbEventSubscription() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -92,7 +94,7 @@ func resourceAwsDbEventSubscriptionCreate(d *schema.ResourceData, meta interface
 		name = resource.UniqueId()
 	}
 
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	sourceIdsSet := d.Get("source_ids").(*schema.Set)
 	sourceIds := make([]*string, sourceIdsSet.Len())
@@ -207,7 +209,7 @@ func resourceAwsDbEventSubscriptionRead(d *schema.ResourceData, meta interface{}
 	if len(resp.TagList) > 0 {
 		dt = resp.TagList
 	}
-	if err := d.Set("tags", tagsToMapRDS(dt)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapRDS(dt, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -313,6 +315,7 @@ func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("source_ids") {
@@ -25,6 +25,7 @@ func resourceAwsDbInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDbInstanceImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
@@ -265,6 +266,7 @@ func resourceAwsDbInstance() *schema.Resource {
 				ConflictsWith: []string{
 					"snapshot_identifier",
 					"replicate_source_db",
+					"restore_to_point_in_time",
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -368,6 +370,50 @@ func resourceAwsDbInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"restore_to_point_in_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"s3_import",
+					"snapshot_identifier",
+					"replicate_source_db",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restore_time": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validateUTCTimestamp,
+							ConflictsWith: []string{"restore_to_point_in_time.0.use_latest_restorable_time"},
+						},
+
+						"source_db_instance_identifier": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_to_point_in_time.0.source_dbi_resource_id"},
+						},
+
+						"source_dbi_resource_id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_to_point_in_time.0.source_db_instance_identifier"},
+						},
+
+						"use_latest_restorable_time": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_to_point_in_time.0.restore_time"},
+						},
+					},
+				},
+			},
+
 			"auto_minor_version_upgrade": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -475,7 +521,8 @@ func resourceAwsDbInstance() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -499,7 +546,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	// we expect everything to be in sync before returning completion.
 	var requiresRebootDbInstance bool
 
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -1030,6 +1077,153 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			return fmt.Errorf("Error creating DB Instance: %s", err)
 		}
+	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
+		input := expandDbInstanceRestoreToPointInTime(v.([]interface{}))
+		input.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
+		input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
+		input.DBInstanceClass = aws.String(d.Get("instance_class").(string))
+		input.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
+		input.PubliclyAccessible = aws.Bool(d.Get("publicly_accessible").(bool))
+		input.Tags = tags
+		input.TargetDBInstanceIdentifier = aws.String(d.Get("identifier").(string))
+
+		if v, ok := d.GetOk("availability_zone"); ok {
+			input.AvailabilityZone = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("domain"); ok {
+			input.Domain = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("domain_iam_role_name"); ok {
+			input.DomainIAMRoleName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && len(v.([]interface{})) > 0 {
+			input.EnableCloudwatchLogsExports = expandStringList(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("engine"); ok {
+			input.Engine = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+			input.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("iops"); ok {
+			input.Iops = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("license_model"); ok {
+			input.LicenseModel = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("multi_az"); ok {
+			input.MultiAZ = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("name"); ok {
+			// "Note: This parameter [DBName] doesn't apply to the MySQL, PostgreSQL, or MariaDB engines."
+			// https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_RestoreDBInstanceToPointInTime.html
+			switch strings.ToLower(d.Get("engine").(string)) {
+			case "mysql", "postgres", "mariadb":
+				// skip
+			default:
+				input.DBName = aws.String(v.(string))
+			}
+		}
+
+		if v, ok := d.GetOk("option_group_name"); ok {
+			input.OptionGroupName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("parameter_group_name"); ok {
+			input.DBParameterGroupName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("port"); ok {
+			input.Port = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("storage_type"); ok {
+			input.StorageType = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("db_subnet_group_name"); ok {
+			input.DBSubnetGroupName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("tde_credential_arn"); ok {
+			input.TdeCredentialArn = aws.String(v.(string))
+		}
+
+		if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
+			input.VpcSecurityGroupIds = expandStringSet(v)
+		}
+
+		if v, ok := d.GetOk("allocated_storage"); ok {
+			modifyDbInstanceInput.AllocatedStorage = aws.Int64(int64(v.(int)))
+			requiresModifyDbInstance = true
+		}
+
+		if v, ok := d.GetOkExists("backup_retention_period"); ok {
+			modifyDbInstanceInput.BackupRetentionPeriod = aws.Int64(int64(v.(int)))
+			requiresModifyDbInstance = true
+		}
+
+		if v, ok := d.GetOk("backup_window"); ok {
+			modifyDbInstanceInput.PreferredBackupWindow = aws.String(v.(string))
+			requiresModifyDbInstance = true
+		}
+
+		if v, ok := d.GetOk("maintenance_window"); ok {
+			modifyDbInstanceInput.PreferredMaintenanceWindow = aws.String(v.(string))
+			requiresModifyDbInstance = true
+		}
+
+		if v, ok := d.GetOk("max_allocated_storage"); ok {
+			modifyDbInstanceInput.MaxAllocatedStorage = aws.Int64(int64(v.(int)))
+			requiresModifyDbInstance = true
+		}
+
+		if v, ok := d.GetOk("monitoring_interval"); ok {
+			modifyDbInstanceInput.MonitoringInterval = aws.Int64(int64(v.(int)))
+			requiresModifyDbInstance = true
+		}
+
+		if v, ok := d.GetOk("monitoring_role_arn"); ok {
+			modifyDbInstanceInput.MonitoringRoleArn = aws.String(v.(string))
+			requiresModifyDbInstance = true
+		}
+
+		if v, ok := d.GetOk("password"); ok {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(v.(string))
+			requiresModifyDbInstance = true
+		}
+
+		if v := d.Get("security_group_names").(*schema.Set); v.Len() > 0 {
+			modifyDbInstanceInput.DBSecurityGroups = expandStringSet(v)
+			requiresModifyDbInstance = true
+		}
+
+		if v, ok := d.GetOk("performance_insights_enabled"); ok {
+			modifyDbInstanceInput.EnablePerformanceInsights = aws.Bool(v.(bool))
+			requiresModifyDbInstance = true
+
+			if v, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+				modifyDbInstanceInput.PerformanceInsightsKMSKeyId = aws.String(v.(string))
+			}
+
+			if v, ok := d.GetOk("performance_insights_retention_period"); ok {
+				modifyDbInstanceInput.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+			}
+		}
+
+		log.Printf("[DEBUG] DB Instance restore to point in time configuration: %s", input)
+		if _, err := conn.RestoreDBInstanceToPointInTime(input); err != nil {
+			return fmt.Errorf("error creating DB Instance: %s", err)
+		}
 	} else {
 		if _, ok := d.GetOk("allocated_storage"); !ok {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string))
@@ -1357,7 +1551,7 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if len(resp.TagList) > 0 {
 		dt = resp.TagList
 	}
-	d.Set("tags", tagsToMapRDS(dt))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapRDS(dt, meta.(*AWSClient).ignoreTagsConfig))
 
 	// Create an empty schema.Set to hold all vpc security group ids
 	ids := &schema.Set{
@@ -1675,11 +1869,12 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -1826,3 +2021,32 @@ var resourceAwsDbInstanceUpdatePendingStates = []string{
 	"storage-full",
 	"upgrading",
 }
+
+func expandDbInstanceRestoreToPointInTime(l []interface{}) *rds.RestoreDBInstanceToPointInTimeInput {
+	input := &rds.RestoreDBInstanceToPointInTimeInput{}
+
+	if len(l) == 0 || l[0] == nil {
+		return input
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["restore_time"].(string); ok && v != "" {
+		parsedTime, _ := time.Parse(time.RFC3339, v)
+		input.RestoreTime = aws.Time(parsedTime)
+	}
+
+	if v, ok := m["source_db_instance_identifier"].(string); ok && v != "" {
+		input.SourceDBInstanceIdentifier = aws.String(v)
+	}
+
+	if v, ok := m["source_dbi_resource_id"].(string); ok && v != "" {
+		input.SourceDbiResourceId = aws.String(v)
+	}
+
+	if v, ok := m["use_latest_restorable_time"].(bool); ok && v {
+		input.UseLatestRestorableTime = aws.Bool(v)
+	}
+
+	return input
+}
@@ -17,50 +17,51 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
-		Name: "aws_db_instance",
-		F:    testSweepDbInstances,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_db_instance",
+		Description: "RDS DB Instance",
+		List:        testSweepDbInstances,
 	})
 }
 
-func testSweepDbInstances(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).rdsconn
+func testSweepDbInstances(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.rdsconn
+	var resources []*testSweepResource
 
-	err = conn.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(out *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+	err := conn.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(out *rds.DescribeDBInstancesOutput, lastPage bool) bool {
 		for _, dbi := range out.DBInstances {
-			log.Printf("[INFO] Deleting DB instance: %s", *dbi.DBInstanceIdentifier)
-
-			_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
-				DBInstanceIdentifier: dbi.DBInstanceIdentifier,
-				SkipFinalSnapshot:    aws.Bool(true),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB instance %s: %s",
-					*dbi.DBInstanceIdentifier, err)
+			if dbi == nil {
 				continue
 			}
 
-			err = waitUntilAwsDbInstanceIsDeleted(*dbi.DBInstanceIdentifier, conn, 40*time.Minute)
-			if err != nil {
-				log.Printf("[ERROR] Failure while waiting for DB instance %s to be deleted: %s",
-					*dbi.DBInstanceIdentifier, err)
-			}
+			id := aws.StringValue(dbi.DBInstanceIdentifier)
+
+			resources = append(resources, &testSweepResource{
+				ID:        id,
+				Name:      id,
+				CreatedAt: dbi.InstanceCreateTime,
+				Delete: func() error {
+					_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+						DBInstanceIdentifier: aws.String(id),
+						SkipFinalSnapshot:    aws.Bool(true),
+					})
+
+					if isAWSErr(err, rds.ErrCodeDBInstanceNotFoundFault, "") {
+						return nil
+					}
+
+					if err != nil {
+						return err
+					}
+
+					return waitUntilAwsDbInstanceIsDeleted(id, conn, 40*time.Minute)
+				},
+			})
 		}
 		return !lastPage
 	})
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping RDS DB Instance sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving DB instances: %s", err)
-	}
 
-	return nil
+	return resources, err
 }
 
 func TestAccAWSDBInstance_basic(t *testing.T) {
@@ -943,6 +944,68 @@ func TestAccAWSDBInstance_S3Import(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_RestoreToPointInTime_SourceIdentifier(t *testing.T) {
+	var dbInstance, sourceDbInstance rds.DBInstance
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	sourceName := "aws_db_instance.test"
+	resourceName := "aws_db_instance.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfig_RestoreToPointInTime_SourceIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists(sourceName, &sourceDbInstance),
+					testAccCheckAWSDBInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "restore_to_point_in_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restore_to_point_in_time.0.use_latest_restorable_time", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"restore_to_point_in_time",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSDBInstance_RestoreToPointInTime_SourceResourceID(t *testing.T) {
+	var dbInstance, sourceDbInstance rds.DBInstance
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	sourceName := "aws_db_instance.test"
+	resourceName := "aws_db_instance.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfig_RestoreToPointInTime_SourceResourceID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists(sourceName, &sourceDbInstance),
+					testAccCheckAWSDBInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "restore_to_point_in_time.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_to_point_in_time.0.source_dbi_resource_id", sourceName, "resource_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstance_SnapshotIdentifier(t *testing.T) {
 	var dbInstance, sourceDbInstance rds.DBInstance
 	var dbSnapshot rds.DBSnapshot
@@ -4447,6 +4510,58 @@ resource "aws_db_instance" "test" {
 `, rName, rName, rName)
 }
 
+func testAccAWSDBInstanceConfig_RestoreToPointInTime_SourceIdentifier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage       = 5
+  backup_retention_period = 1
+  engine                  = "mariadb"
+  identifier              = "%[1]s-source"
+  instance_class          = "db.t2.micro"
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_instance" "restore" {
+  identifier          = %[1]q
+  instance_class      = "${aws_db_instance.test.instance_class}"
+  skip_final_snapshot = true
+
+  restore_to_point_in_time {
+    source_db_instance_identifier = "${aws_db_instance.test.identifier}"
+    use_latest_restorable_time    = true
+  }
+}
+`, rName)
+}
+
+func testAccAWSDBInstanceConfig_RestoreToPointInTime_SourceResourceID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage       = 5
+  backup_retention_period = 1
+  engine                  = "mariadb"
+  identifier              = "%[1]s-source"
+  instance_class          = "db.t2.micro"
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_instance" "restore" {
+  identifier          = %[1]q
+  instance_class      = "${aws_db_instance.test.instance_class}"
+  skip_final_snapshot = true
+
+  restore_to_point_in_time {
+    source_dbi_resource_id     = "${aws_db_instance.test.resource_id}"
+    use_latest_restorable_time = true
+  }
+}
+`, rName)
+}
+
 func testAccAWSDBInstanceConfig_SnapshotIdentifier(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_db_instance" "source" {
@@ -24,6 +24,7 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(15 * time.Minute),
@@ -117,14 +118,15 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 				Set: resourceAwsDbOptionHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
 
 func resourceAwsDbOptionGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -209,7 +211,7 @@ func resourceAwsDbOptionGroupRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error listing tags for RDS Option Group (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapRDS(resp.TagList)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapRDS(resp.TagList, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -295,6 +297,7 @@ func resourceAwsDbOptionGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return resourceAwsDbOptionGroupRead(d, meta)
@@ -3,11 +3,9 @@ package aws
 import (
 	"errors"
 	"fmt"
-	"log"
 	"regexp"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -17,58 +15,49 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
-		Name: "aws_db_option_group",
-		F:    testSweepDbOptionGroups,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_db_option_group",
+		Description: "RDS DB Option Group",
+		List:        testSweepDbOptionGroups,
+		// AWS believes the RDS Option Group is still in use
+		RetryableErrorCodes: []string{rds.ErrCodeInvalidOptionGroupStateFault},
 	})
 }
 
-func testSweepDbOptionGroups(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func testSweepDbOptionGroups(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.rdsconn
+	var resources []*testSweepResource
 
-	conn := client.(*AWSClient).rdsconn
+	err := conn.DescribeOptionGroupsPages(&rds.DescribeOptionGroupsInput{}, func(out *rds.DescribeOptionGroupsOutput, lastPage bool) bool {
+		for _, og := range out.OptionGroupsList {
+			if og == nil {
+				continue
+			}
 
-	opts := rds.DescribeOptionGroupsInput{}
-	resp, err := conn.DescribeOptionGroups(&opts)
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping RDS DB Option Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error describing DB Option Groups in Sweeper: %s", err)
-	}
+			name := aws.StringValue(og.OptionGroupName)
 
-	for _, og := range resp.OptionGroupsList {
-		if strings.HasPrefix(aws.StringValue(og.OptionGroupName), "default") {
-			continue
-		}
+			if strings.HasPrefix(name, "default") {
+				continue
+			}
 
-		log.Printf("[INFO] Deleting RDS Option Group: %s", aws.StringValue(og.OptionGroupName))
+			input := &rds.DeleteOptionGroupInput{
+				OptionGroupName: og.OptionGroupName,
+			}
 
-		deleteOpts := &rds.DeleteOptionGroupInput{
-			OptionGroupName: og.OptionGroupName,
+			resources = append(resources, &testSweepResource{
+				ID:   name,
+				Name: name,
+				Delete: func() error {
+					_, err := conn.DeleteOptionGroup(input)
+					return err
+				},
+			})
 		}
 
-		ret := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteOptionGroup(deleteOpts)
-			if err != nil {
-				if isAWSErr(err, rds.ErrCodeInvalidOptionGroupStateFault, "") {
-					log.Printf("[DEBUG] AWS believes the RDS Option Group is still in use, retrying")
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if ret != nil {
-			return fmt.Errorf("Error Deleting DB Option Group (%s) in Sweeper: %s", *og.OptionGroupName, ret)
-		}
-	}
+		return !lastPage
+	})
 
-	return nil
+	return resources, err
 }
 
 func TestAccAWSDBOptionGroup_basic(t *testing.T) {
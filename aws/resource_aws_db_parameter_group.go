@@ -24,6 +24,7 @@ func resourceAwsDbParameterGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -81,14 +82,15 @@ func resourceAwsDbParameterGroup() *schema.Resource {
 				Set: resourceAwsDbParameterHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
 
 func resourceAwsDbParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -242,7 +244,7 @@ func resourceAwsDbParameterGroupRead(d *schema.ResourceData, meta interface{}) e
 	if len(resp.TagList) > 0 {
 		dt = resp.TagList
 	}
-	d.Set("tags", tagsToMapRDS(dt))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapRDS(dt, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -300,6 +302,7 @@ func resourceAwsDbParameterGroupUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -18,31 +18,26 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
-		Name: "aws_db_parameter_group",
-		F:    testSweepRdsDbParameterGroups,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_db_parameter_group",
+		Description: "RDS DB Parameter Group",
 		Dependencies: []string{
 			"aws_db_instance",
 		},
+		List: testSweepRdsDbParameterGroups,
 	})
 }
 
-func testSweepRdsDbParameterGroups(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).rdsconn
+func testSweepRdsDbParameterGroups(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.rdsconn
+	var resources []*testSweepResource
 
-	err = conn.DescribeDBParameterGroupsPages(&rds.DescribeDBParameterGroupsInput{}, func(out *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool {
+	err := conn.DescribeDBParameterGroupsPages(&rds.DescribeDBParameterGroupsInput{}, func(out *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool {
 		for _, dbpg := range out.DBParameterGroups {
 			if dbpg == nil {
 				continue
 			}
 
-			input := &rds.DeleteDBParameterGroupInput{
-				DBParameterGroupName: dbpg.DBParameterGroupName,
-			}
 			name := aws.StringValue(dbpg.DBParameterGroupName)
 
 			if strings.HasPrefix(name, "default.") {
@@ -50,29 +45,24 @@ func testSweepRdsDbParameterGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting DB Parameter Group: %s", name)
-
-			_, err := conn.DeleteDBParameterGroup(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB Parameter Group %s: %s", name, err)
-				continue
+			input := &rds.DeleteDBParameterGroupInput{
+				DBParameterGroupName: dbpg.DBParameterGroupName,
 			}
+
+			resources = append(resources, &testSweepResource{
+				ID:   name,
+				Name: name,
+				Delete: func() error {
+					_, err := conn.DeleteDBParameterGroup(input)
+					return err
+				},
+			})
 		}
 
 		return !lastPage
 	})
 
-	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS DB Parameter Group sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error retrieving DB Parameter Groups: %s", err)
-	}
-
-	return nil
+	return resources, err
 }
 
 func TestAccAWSDBParameterGroup_importBasic(t *testing.T) {
@@ -24,6 +24,7 @@ func resourceAwsDbSecurityGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -76,14 +77,15 @@ func resourceAwsDbSecurityGroup() *schema.Resource {
 				Set: resourceAwsDbSecurityGroupIngressHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
 
 func resourceAwsDbSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var err error
 	var errs []error
@@ -191,7 +193,7 @@ func resourceAwsDbSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 	if len(resp.TagList) > 0 {
 		dt = resp.TagList
 	}
-	d.Set("tags", tagsToMapRDS(dt))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapRDS(dt, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -205,6 +207,7 @@ func resourceAwsDbSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("ingress") {
@@ -16,53 +16,50 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
-		Name: "aws_db_subnet_group",
-		F:    testSweepRdsDbSubnetGroups,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_db_subnet_group",
+		Description: "RDS DB Subnet Group",
 		Dependencies: []string{
 			"aws_db_instance",
 		},
+		List: testSweepRdsDbSubnetGroups,
 	})
 }
 
-func testSweepRdsDbSubnetGroups(region string) error {
-	client, err := sharedClientForRegion(region)
+func testSweepRdsDbSubnetGroups(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.rdsconn
+	var resources []*testSweepResource
 
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-
-	conn := client.(*AWSClient).rdsconn
-	input := &rds.DescribeDBSubnetGroupsInput{}
-
-	err = conn.DescribeDBSubnetGroupsPages(input, func(out *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
+	err := conn.DescribeDBSubnetGroupsPages(&rds.DescribeDBSubnetGroupsInput{}, func(out *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
 		for _, dbSubnetGroup := range out.DBSubnetGroups {
-			name := aws.StringValue(dbSubnetGroup.DBSubnetGroupName)
-			input := &rds.DeleteDBSubnetGroupInput{
-				DBSubnetGroupName: dbSubnetGroup.DBSubnetGroupName,
+			if dbSubnetGroup == nil {
+				continue
 			}
 
-			log.Printf("[INFO] Deleting RDS DB Subnet Group: %s", name)
+			name := aws.StringValue(dbSubnetGroup.DBSubnetGroupName)
 
-			_, err := conn.DeleteDBSubnetGroup(input)
+			if name == "default" {
+				log.Printf("[INFO] Skipping RDS DB Subnet Group: %s", name)
+				continue
+			}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS DB Subnet Group (%s): %s", name, err)
+			input := &rds.DeleteDBSubnetGroupInput{
+				DBSubnetGroupName: dbSubnetGroup.DBSubnetGroupName,
 			}
+
+			resources = append(resources, &testSweepResource{
+				ID:   name,
+				Name: name,
+				Delete: func() error {
+					_, err := conn.DeleteDBSubnetGroup(input)
+					return err
+				},
+			})
 		}
 		return !lastPage
 	})
 
-	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS DB Subnet Group sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error retrieving RDS DB Subnet Groups: %s", err)
-	}
-
-	return nil
+	return resources, err
 }
 
 func TestAccAWSDBSubnetGroup_importBasic(t *testing.T) {
@@ -22,9 +22,10 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsDefaultNetworkAclCreate,
 		// We reuse aws_network_acl's read method, the operations are the same
-		Read:   resourceAwsNetworkAclRead,
-		Delete: resourceAwsDefaultNetworkAclDelete,
-		Update: resourceAwsDefaultNetworkAclUpdate,
+		Read:          resourceAwsNetworkAclRead,
+		Delete:        resourceAwsDefaultNetworkAclDelete,
+		CustomizeDiff: setTagsDiff,
+		Update:        resourceAwsDefaultNetworkAclUpdate,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -142,7 +143,8 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 				Set: resourceAwsNetworkAclEntryHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"owner_id": {
 				Type:     schema.TypeString,
@@ -236,6 +238,7 @@ func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
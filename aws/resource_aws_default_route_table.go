@@ -11,10 +11,11 @@ import (
 
 func resourceAwsDefaultRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDefaultRouteTableCreate,
-		Read:   resourceAwsDefaultRouteTableRead,
-		Update: resourceAwsRouteTableUpdate,
-		Delete: resourceAwsDefaultRouteTableDelete,
+		Create:        resourceAwsDefaultRouteTableCreate,
+		Read:          resourceAwsDefaultRouteTableRead,
+		Update:        resourceAwsRouteTableUpdate,
+		Delete:        resourceAwsDefaultRouteTableDelete,
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
@@ -91,7 +92,8 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 				Set: resourceAwsRouteTableHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"owner_id": {
 				Type:     schema.TypeString,
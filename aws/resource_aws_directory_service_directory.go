@@ -22,6 +22,7 @@ func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -62,7 +63,8 @@ func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"vpc_settings": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -215,7 +217,7 @@ func createDirectoryConnector(dsconn *directoryservice.DirectoryService, d *sche
 	input := directoryservice.ConnectDirectoryInput{
 		Name:     aws.String(d.Get("name").(string)),
 		Password: aws.String(d.Get("password").(string)),
-		Tags:     tagsFromMapDS(d.Get("tags").(map[string]interface{})),
+		Tags:     tagsFromMapDS(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -250,7 +252,7 @@ func createSimpleDirectoryService(dsconn *directoryservice.DirectoryService, d *
 	input := directoryservice.CreateDirectoryInput{
 		Name:     aws.String(d.Get("name").(string)),
 		Password: aws.String(d.Get("password").(string)),
-		Tags:     tagsFromMapDS(d.Get("tags").(map[string]interface{})),
+		Tags:     tagsFromMapDS(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -285,7 +287,7 @@ func createActiveDirectoryService(dsconn *directoryservice.DirectoryService, d *
 	input := directoryservice.CreateMicrosoftADInput{
 		Name:     aws.String(d.Get("name").(string)),
 		Password: aws.String(d.Get("password").(string)),
-		Tags:     tagsFromMapDS(d.Get("tags").(map[string]interface{})),
+		Tags:     tagsFromMapDS(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -481,7 +483,7 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return fmt.Errorf("Failed to get Directory service tags (id: %s): %s", d.Id(), err)
 	}
-	d.Set("tags", tagsToMapDS(tagList.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapDS(tagList.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -24,6 +24,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"certificate_arn": {
@@ -115,10 +116,8 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 					dms.DmsSslModeValueVerifyFull,
 				}, false),
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -230,7 +229,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
 		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
 		EngineName:         aws.String(d.Get("engine_name").(string)),
-		Tags:               dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:               dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	switch d.Get("engine_name").(string) {
@@ -351,7 +350,7 @@ func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	return d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	return setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, dmsTagsToMap(tagsResp.TagList, meta.(*AWSClient).ignoreTagsConfig))
 }
 
 func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -399,7 +398,7 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("endpoint_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -21,6 +21,7 @@ func resourceAwsDmsReplicationSubnetGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"replication_subnet_group_arn": {
@@ -43,10 +44,8 @@ func resourceAwsDmsReplicationSubnetGroup() *schema.Resource {
 				Set:      schema.HashString,
 				Required: true,
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -62,7 +61,7 @@ func resourceAwsDmsReplicationSubnetGroupCreate(d *schema.ResourceData, meta int
 		ReplicationSubnetGroupIdentifier:  aws.String(d.Get("replication_subnet_group_id").(string)),
 		ReplicationSubnetGroupDescription: aws.String(d.Get("replication_subnet_group_description").(string)),
 		SubnetIds:                         expandStringList(d.Get("subnet_ids").(*schema.Set).List()),
-		Tags:                              dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:                              dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Println("[DEBUG] DMS create replication subnet group:", request)
@@ -117,7 +116,7 @@ func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, dmsTagsToMap(tagsResp.TagList, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -136,7 +135,7 @@ func resourceAwsDmsReplicationSubnetGroupUpdate(d *schema.ResourceData, meta int
 		request.ReplicationSubnetGroupDescription = aws.String(d.Get("replication_subnet_group_description").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("replication_subnet_group_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -24,6 +24,7 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"cdc_start_time": {
@@ -74,10 +75,8 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				ValidateFunc:     validation.ValidateJsonString,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"target_endpoint_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -97,7 +96,7 @@ func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{
 		ReplicationTaskIdentifier: aws.String(d.Get("replication_task_id").(string)),
 		SourceEndpointArn:         aws.String(d.Get("source_endpoint_arn").(string)),
 		TableMappings:             aws.String(d.Get("table_mappings").(string)),
-		Tags:                      dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:                      dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 		TargetEndpointArn:         aws.String(d.Get("target_endpoint_arn").(string)),
 	}
 
@@ -172,7 +171,7 @@ func resourceAwsDmsReplicationTaskRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, dmsTagsToMap(tagsResp.TagList, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -209,7 +208,7 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("replication_task_arn").(string), d, meta)
 		if err != nil {
 			return err
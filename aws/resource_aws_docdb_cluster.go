@@ -23,6 +23,7 @@ func resourceAwsDocDBCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDocDBClusterImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -235,7 +236,8 @@ func resourceAwsDocDBCluster() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -251,7 +253,7 @@ func resourceAwsDocDBClusterImport(
 
 func resourceAwsDocDBClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).docdbconn
-	tags := tagsFromMapDocDB(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapDocDB(d.Get("tags_all").(map[string]interface{}))
 
 	// Some API calls (e.g. RestoreDBClusterFromSnapshot do not support all
 	// parameters to correctly apply all settings in one pass. For missing
@@ -559,7 +561,7 @@ func resourceAwsDocDBClusterRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Fetch and save tags
-	if err := saveTagsDocDB(conn, d, aws.StringValue(dbc.DBClusterArn)); err != nil {
+	if err := saveTagsDocDB(conn, d, aws.StringValue(dbc.DBClusterArn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		log.Printf("[WARN] Failed to save tags for DocDB Cluster (%s): %s", aws.StringValue(dbc.DBClusterIdentifier), err)
 	}
 
@@ -655,12 +657,14 @@ func resourceAwsDocDBClusterUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsDocDB(conn, d); err != nil {
 			return err
 		}
 
 		d.SetPartial("tags")
+
+		d.SetPartial("tags_all")
 	}
 
 	return resourceAwsDocDBClusterRead(d, meta)
@@ -25,6 +25,7 @@ func resourceAwsDocDBClusterParameterGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -84,7 +85,8 @@ func resourceAwsDocDBClusterParameterGroup() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 
@@ -92,7 +94,7 @@ func resourceAwsDocDBClusterParameterGroup() *schema.Resource {
 
 func resourceAwsDocDBClusterParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).docdbconn
-	tags := tagsFromMapDocDB(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapDocDB(d.Get("tags_all").(map[string]interface{}))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -174,7 +176,7 @@ func resourceAwsDocDBClusterParameterGroupRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error listing tags for DocDB Cluster Parameter Group (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapDocDB(resp.TagList)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapDocDB(resp.TagList, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("Error setting docdb parameter group tags: %s", err)
 	}
 
@@ -237,6 +239,7 @@ func resourceAwsDocDBClusterParameterGroupUpdate(d *schema.ResourceData, meta in
 		return err
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 
@@ -21,6 +21,7 @@ func resourceAwsDxConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -47,7 +48,8 @@ func resourceAwsDxConnection() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"has_logical_redundancy": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -127,7 +129,7 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("has_logical_redundancy", connection.HasLogicalRedundancy)
 	d.Set("aws_device", connection.AwsDeviceV2)
 
-	err1 := getTagsDX(conn, d, arn)
+	err1 := getTagsDX(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	return err1
 }
 
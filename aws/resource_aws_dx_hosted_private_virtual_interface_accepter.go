@@ -20,6 +20,7 @@ func resourceAwsDxHostedPrivateVirtualInterfaceAccepter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDxHostedPrivateVirtualInterfaceAccepterImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -43,7 +44,8 @@ func resourceAwsDxHostedPrivateVirtualInterfaceAccepter() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"vpn_gateway_id"},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -120,7 +122,7 @@ func resourceAwsDxHostedPrivateVirtualInterfaceAccepterRead(d *schema.ResourceDa
 	d.Set("virtual_interface_id", vif.VirtualInterfaceId)
 	d.Set("vpn_gateway_id", vif.VirtualGatewayId)
 	d.Set("dx_gateway_id", vif.DirectConnectGatewayId)
-	err1 := getTagsDX(conn, d, d.Get("arn").(string))
+	err1 := getTagsDX(conn, d, d.Get("arn").(string), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	return err1
 }
 
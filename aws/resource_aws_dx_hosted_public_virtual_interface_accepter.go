@@ -20,6 +20,7 @@ func resourceAwsDxHostedPublicVirtualInterfaceAccepter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDxHostedPublicVirtualInterfaceAccepterImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -31,7 +32,8 @@ func resourceAwsDxHostedPublicVirtualInterfaceAccepter() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -94,7 +96,7 @@ func resourceAwsDxHostedPublicVirtualInterfaceAccepterRead(d *schema.ResourceDat
 	}
 
 	d.Set("virtual_interface_id", vif.VirtualInterfaceId)
-	err1 := getTagsDX(conn, d, d.Get("arn").(string))
+	err1 := getTagsDX(conn, d, d.Get("arn").(string), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	return err1
 }
 
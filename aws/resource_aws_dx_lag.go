@@ -21,6 +21,7 @@ func resourceAwsDxLag() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -58,7 +59,8 @@ func resourceAwsDxLag() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"has_logical_redundancy": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -147,7 +149,7 @@ func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("jumbo_frame_capable", lag.JumboFrameCapable)
 	d.Set("has_logical_redundancy", lag.HasLogicalRedundancy)
 
-	err1 := getTagsDX(conn, d, arn)
+	err1 := getTagsDX(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	return err1
 }
 
@@ -182,6 +184,7 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
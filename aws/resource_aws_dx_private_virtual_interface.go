@@ -21,6 +21,7 @@ func resourceAwsDxPrivateVirtualInterface() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDxPrivateVirtualInterfaceImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -94,7 +95,8 @@ func resourceAwsDxPrivateVirtualInterface() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"aws_device": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -194,7 +196,7 @@ func resourceAwsDxPrivateVirtualInterfaceRead(d *schema.ResourceData, meta inter
 	d.Set("mtu", vif.Mtu)
 	d.Set("jumbo_frame_capable", vif.JumboFrameCapable)
 	d.Set("aws_device", vif.AwsDeviceV2)
-	err1 := getTagsDX(conn, d, d.Get("arn").(string))
+	err1 := getTagsDX(conn, d, d.Get("arn").(string), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	return err1
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -21,7 +22,10 @@ func resourceAwsDxPublicVirtualInterface() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDxPublicVirtualInterfaceImport,
 		},
-		CustomizeDiff: resourceAwsDxPublicVirtualInterfaceCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			resourceAwsDxPublicVirtualInterfaceCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -80,7 +84,8 @@ func resourceAwsDxPublicVirtualInterface() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				MinItems: 1,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"aws_device": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -165,7 +170,7 @@ func resourceAwsDxPublicVirtualInterfaceRead(d *schema.ResourceData, meta interf
 	d.Set("amazon_address", vif.AmazonAddress)
 	d.Set("route_filter_prefixes", flattenDxRouteFilterPrefixes(vif.RouteFilterPrefixes))
 	d.Set("aws_device", vif.AwsDeviceV2)
-	err1 := getTagsDX(conn, d, d.Get("arn").(string))
+	err1 := getTagsDX(conn, d, d.Get("arn").(string), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	return err1
 }
 
@@ -34,12 +34,24 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
 			func(diff *schema.ResourceDiff, v interface{}) error {
 				return validateDynamoDbStreamSpec(diff)
 			},
 			func(diff *schema.ResourceDiff, v interface{}) error {
 				return validateDynamoDbTableAttributes(diff)
 			},
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				// kms_key_arn is Computed, so only new tables, including
+				// replacements, have it solely from the configuration
+				if diff.Id() != "" || !diff.NewValueKnown("server_side_encryption.0.enabled") {
+					return nil
+				}
+				if options, ok := diff.Get("server_side_encryption").([]interface{}); ok && len(options) > 0 && options[0] != nil {
+					return validateDynamoDbTableServerSideEncryption(options[0].(map[string]interface{}))
+				}
+				return nil
+			},
 			func(diff *schema.ResourceDiff, v interface{}) error {
 				if diff.Id() != "" && diff.HasChange("server_side_encryption") {
 					o, n := diff.GetChange("server_side_encryption")
@@ -255,10 +267,18 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						"kms_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -289,7 +309,7 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Creating DynamoDB table with key schema: %#v", keySchemaMap)
 
-	tags := tagsFromMapDynamoDb(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapDynamoDb(d.Get("tags_all").(map[string]interface{}))
 
 	req := &dynamodb.CreateTableInput{
 		TableName:   aws.String(d.Get("name").(string)),
@@ -350,6 +370,9 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 		}
 
 		s := options[0].(map[string]interface{})
+		if err := validateDynamoDbTableServerSideEncryption(s); err != nil {
+			return err
+		}
 		req.SSESpecification = expandDynamoDbEncryptAtRestOptions(s)
 	}
 
@@ -564,7 +587,7 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsDynamoDb(conn, d); err != nil {
 			return fmt.Errorf("error updating DynamoDB Table (%s) tags: %s", d.Id(), err)
 		}
@@ -610,11 +633,11 @@ func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error setting ttl: %s", err)
 	}
 
-	tags, err := readDynamoDbTableTags(d.Get("arn").(string), conn)
+	tags, err := readDynamoDbTableTags(d.Get("arn").(string), conn, meta.(*AWSClient).ignoreTagsConfig)
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tags)
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags)
 
 	pitrOut, err := conn.DescribeContinuousBackups(&dynamodb.DescribeContinuousBackupsInput{
 		TableName: aws.String(d.Id()),
@@ -771,7 +794,7 @@ func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
 	return nil
 }
 
-func readDynamoDbTableTags(arn string, conn *dynamodb.DynamoDB) (map[string]string, error) {
+func readDynamoDbTableTags(arn string, conn *dynamodb.DynamoDB, ignoreConfig *IgnoreTagsConfig) (map[string]string, error) {
 	output, err := conn.ListTagsOfResource(&dynamodb.ListTagsOfResourceInput{
 		ResourceArn: aws.String(arn),
 	})
@@ -781,7 +804,7 @@ func readDynamoDbTableTags(arn string, conn *dynamodb.DynamoDB) (map[string]stri
 		return nil, fmt.Errorf("Error reading tags from dynamodb resource: %s", err)
 	}
 
-	result := tagsToMapDynamoDb(output.Tags)
+	result := tagsToMapDynamoDb(output.Tags, ignoreConfig)
 
 	// TODO Read NextToken if available
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -16,6 +17,13 @@ func resourceAwsEbsSnapshotCopy() *schema.Resource {
 		Create: resourceAwsEbsSnapshotCopyCreate,
 		Read:   resourceAwsEbsSnapshotCopyRead,
 		Delete: resourceAwsEbsSnapshotCopyDelete,
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			// Default tag changes also require a new resource as tags cannot be updated
+			customdiff.ForceNewIfChange("tags_all", func(old, new, meta interface{}) bool {
+				return true
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"volume_id": {
@@ -63,11 +71,8 @@ func resourceAwsEbsSnapshotCopy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ForceNew: true,
-			},
+			"tags":     tagsSchemaForceNew(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -132,7 +137,7 @@ func resourceAwsEbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(snapshot.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
@@ -20,6 +20,7 @@ func resourceAwsEc2CapacityReservation() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -87,7 +88,8 @@ func resourceAwsEc2CapacityReservation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"tenancy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -137,7 +139,7 @@ func resourceAwsEc2CapacityReservationCreate(d *schema.ResourceData, meta interf
 		opts.Tenancy = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
 		opts.TagSpecifications = []*ec2.TagSpecification{
 			{
 				// There is no constant in the SDK for this resource type
@@ -197,7 +199,7 @@ func resourceAwsEc2CapacityReservationRead(d *schema.ResourceData, meta interfac
 	d.Set("instance_platform", reservation.InstancePlatform)
 	d.Set("instance_type", reservation.InstanceType)
 
-	if err := d.Set("tags", tagsToMap(reservation.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(reservation.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -211,11 +213,12 @@ func resourceAwsEc2CapacityReservationUpdate(d *schema.ResourceData, meta interf
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTags(conn, d); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEcsCluster() *schema.Resource {
@@ -22,6 +24,7 @@ func resourceAwsEcsCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsEcsClusterImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -29,11 +32,41 @@ func resourceAwsEcsCluster() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			// The vendored SDK has no UpdateClusterSettings, so settings
+			// can only be applied when the cluster is created.
+			"setting": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ecs.ClusterSettingNameContainerInsights,
+							}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"enabled",
+								"disabled",
+							}, false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -56,10 +89,16 @@ func resourceAwsEcsClusterCreate(d *schema.ResourceData, meta interface{}) error
 	clusterName := d.Get("name").(string)
 	log.Printf("[DEBUG] Creating ECS cluster %s", clusterName)
 
-	out, err := conn.CreateCluster(&ecs.CreateClusterInput{
+	input := &ecs.CreateClusterInput{
 		ClusterName: aws.String(clusterName),
-		Tags:        tagsFromMapECS(d.Get("tags").(map[string]interface{})),
-	})
+		Tags:        tagsFromMapECS(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("setting"); ok {
+		input.Settings = expandEcsSettings(v.(*schema.Set).List())
+	}
+
+	out, err := conn.CreateCluster(input)
 	if err != nil {
 		return err
 	}
@@ -75,7 +114,8 @@ func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	input := &ecs.DescribeClustersInput{
 		Clusters: []*string{aws.String(d.Id())},
-		Include:  []*string{aws.String(ecs.ClusterFieldTags)},
+		// SETTINGS is not yet an ecs.ClusterField value in the vendored SDK
+		Include: []*string{aws.String(ecs.ClusterFieldTags), aws.String("SETTINGS")},
 	}
 
 	log.Printf("[DEBUG] Reading ECS Cluster: %s", input)
@@ -132,7 +172,11 @@ func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", cluster.ClusterArn)
 	d.Set("name", cluster.ClusterName)
 
-	if err := d.Set("tags", tagsToMapECS(cluster.Tags)); err != nil {
+	if err := d.Set("setting", flattenEcsSettings(cluster.Settings)); err != nil {
+		return fmt.Errorf("error setting setting: %s", err)
+	}
+
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapECS(cluster.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -142,39 +186,11 @@ func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsEcsClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
-		oldTagsMap := oldTagsRaw.(map[string]interface{})
-		newTagsMap := newTagsRaw.(map[string]interface{})
-		createTags, removeTags := diffTagsECS(tagsFromMapECS(oldTagsMap), tagsFromMapECS(newTagsMap))
-
-		if len(removeTags) > 0 {
-			removeTagKeys := make([]*string, len(removeTags))
-			for i, removeTag := range removeTags {
-				removeTagKeys[i] = removeTag.Key
-			}
-
-			input := &ecs.UntagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				TagKeys:     removeTagKeys,
-			}
-
-			log.Printf("[DEBUG] Untagging ECS Cluster: %s", input)
-			if _, err := conn.UntagResource(input); err != nil {
-				return fmt.Errorf("error untagging ECS Cluster (%s): %s", d.Id(), err)
-			}
-		}
-
-		if len(createTags) > 0 {
-			input := &ecs.TagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				Tags:        createTags,
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			log.Printf("[DEBUG] Tagging ECS Cluster: %s", input)
-			if _, err := conn.TagResource(input); err != nil {
-				return fmt.Errorf("error tagging ECS Cluster (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.EcsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating ECS Cluster (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -248,3 +264,28 @@ func resourceAwsEcsClusterDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] ECS cluster %q deleted", d.Id())
 	return nil
 }
+
+func expandEcsSettings(l []interface{}) []*ecs.ClusterSetting {
+	settings := make([]*ecs.ClusterSetting, 0, len(l))
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		settings = append(settings, &ecs.ClusterSetting{
+			Name:  aws.String(m["name"].(string)),
+			Value: aws.String(m["value"].(string)),
+		})
+	}
+
+	return settings
+}
+
+func flattenEcsSettings(settings []*ecs.ClusterSetting) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(settings))
+	for _, setting := range settings {
+		l = append(l, map[string]interface{}{
+			"name":  aws.StringValue(setting.Name),
+			"value": aws.StringValue(setting.Value),
+		})
+	}
+
+	return l
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -157,6 +158,89 @@ func TestAccAWSEcsCluster_Tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSEcsCluster_containerInsights(t *testing.T) {
+	var providers []*schema.Provider
+	var cluster1, cluster2 ecs.Cluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSEcsClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsClusterConfigContainerInsights(rName, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName, &cluster1),
+					testAccCheckAWSEcsClusterTagReplacementMarker(&cluster1),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "setting.4047805881.name", "containerInsights"),
+					resource.TestCheckResourceAttr(resourceName, "setting.4047805881.value", "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEcsClusterConfigContainerInsights(rName, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName, &cluster2),
+					testAccCheckAWSEcsClusterRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "setting.1157067080.name", "containerInsights"),
+					resource.TestCheckResourceAttr(resourceName, "setting.1157067080.value", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAWSEcsClusterReplacementMarkerTagKey is the key of a tag added to a
+// cluster outside of Terraform and ignored by the provider, so it is only
+// removed when the cluster is replaced.
+const testAccAWSEcsClusterReplacementMarkerTagKey = "tf-acc-test-replacement-marker"
+
+func testAccCheckAWSEcsClusterTagReplacementMarker(cluster *ecs.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+
+		_, err := conn.TagResource(&ecs.TagResourceInput{
+			ResourceArn: cluster.ClusterArn,
+			Tags: []*ecs.Tag{
+				{
+					Key:   aws.String(testAccAWSEcsClusterReplacementMarkerTagKey),
+					Value: aws.String("true"),
+				},
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("error tagging ECS Cluster (%s): %s", aws.StringValue(cluster.ClusterArn), err)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAWSEcsClusterRecreated checks that a cluster tagged with
+// testAccCheckAWSEcsClusterTagReplacementMarker has been replaced. A cluster
+// recreated with the same name has the same ARN, so the ARNs are not compared.
+func testAccCheckAWSEcsClusterRecreated(before, after *ecs.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, tag := range after.Tags {
+			if aws.StringValue(tag.Key) == testAccAWSEcsClusterReplacementMarkerTagKey {
+				return fmt.Errorf("ECS Cluster (%s) was not recreated", aws.StringValue(before.ClusterArn))
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSEcsClusterDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ecsconn
 
@@ -238,6 +322,25 @@ resource "aws_ecs_cluster" "test" {
 `, rName)
 }
 
+func testAccAWSEcsClusterConfigContainerInsights(rName, value string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    keys = [%[3]q]
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+
+  setting {
+    name  = "containerInsights"
+    value = %[2]q
+  }
+}
+`, rName, value, testAccAWSEcsClusterReplacementMarkerTagKey)
+}
+
 func testAccAWSEcsClusterConfigTags1(rName, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEcsService() *schema.Resource {
@@ -26,6 +27,12 @@ func resourceAwsEcsService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsEcsServiceImport,
 		},
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -46,6 +53,12 @@ func resourceAwsEcsService() *schema.Resource {
 				Required: true,
 			},
 
+			"wait_for_steady_state": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"desired_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -327,7 +340,8 @@ func resourceAwsEcsService() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -349,6 +363,7 @@ func resourceAwsEcsServiceImport(d *schema.ResourceData, meta interface{}) ([]*s
 		Resource:  fmt.Sprintf("cluster/%s", cluster),
 	}.String()
 	d.Set("cluster", clusterArn)
+	d.Set("wait_for_steady_state", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -363,7 +378,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		DeploymentController: expandEcsDeploymentController(d.Get("deployment_controller").([]interface{})),
 		SchedulingStrategy:   aws.String(schedulingStrategy),
 		ServiceName:          aws.String(d.Get("name").(string)),
-		Tags:                 tagsFromMapECS(d.Get("tags").(map[string]interface{})),
+		Tags:                 tagsFromMapECS(d.Get("tags_all").(map[string]interface{})),
 		TaskDefinition:       aws.String(d.Get("task_definition").(string)),
 		EnableECSManagedTags: aws.Bool(d.Get("enable_ecs_managed_tags").(bool)),
 	}
@@ -496,6 +511,12 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] ECS service created: %s", *service.ServiceArn)
 	d.SetId(*service.ServiceArn)
 
+	if d.Get("wait_for_steady_state").(bool) {
+		if err := waitForEcsServiceSteadyState(conn, d.Id(), aws.StringValue(service.ClusterArn), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for ECS Service (%s) to reach steady state: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsEcsServiceRead(d, meta)
 }
 
@@ -623,7 +644,7 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting service_registries for (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapECS(service.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapECS(service.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -857,39 +878,17 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
-		oldTagsMap := oldTagsRaw.(map[string]interface{})
-		newTagsMap := newTagsRaw.(map[string]interface{})
-		createTags, removeTags := diffTagsECS(tagsFromMapECS(oldTagsMap), tagsFromMapECS(newTagsMap))
-
-		if len(removeTags) > 0 {
-			removeTagKeys := make([]*string, len(removeTags))
-			for i, removeTag := range removeTags {
-				removeTagKeys[i] = removeTag.Key
-			}
-
-			input := &ecs.UntagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				TagKeys:     removeTagKeys,
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			log.Printf("[DEBUG] Untagging ECS Cluster: %s", input)
-			if _, err := conn.UntagResource(input); err != nil {
-				return fmt.Errorf("error untagging ECS Cluster (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.EcsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating ECS Service (%s) tags: %s", d.Id(), err)
 		}
+	}
 
-		if len(createTags) > 0 {
-			input := &ecs.TagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				Tags:        createTags,
-			}
-
-			log.Printf("[DEBUG] Tagging ECS Cluster: %s", input)
-			if _, err := conn.TagResource(input); err != nil {
-				return fmt.Errorf("error tagging ECS Cluster (%s): %s", d.Id(), err)
-			}
+	if d.Get("wait_for_steady_state").(bool) {
+		if err := waitForEcsServiceSteadyState(conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for ECS Service (%s) to reach steady state: %s", d.Id(), err)
 		}
 	}
 
@@ -987,6 +986,92 @@ func resourceAwsEcsServiceDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// waitForEcsServiceSteadyState waits until the PRIMARY deployment is the
+// service's only deployment and is running its desired count of tasks.
+// On failure the latest service events are included in the error.
+func waitForEcsServiceSteadyState(conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"STEADY"},
+		Refresh:    ecsServiceSteadyStateRefreshFunc(conn, id, cluster),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err == nil {
+		return nil
+	}
+
+	events, eventsErr := ecsServiceLatestEvents(conn, id, cluster, 5)
+	if eventsErr != nil {
+		log.Printf("[WARN] Unable to read ECS Service (%s) events: %s", id, eventsErr)
+	}
+	if len(events) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%s\n\nLatest service events:\n%s", err, strings.Join(events, "\n"))
+}
+
+func ecsServiceSteadyStateRefreshFunc(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeServices(&ecs.DescribeServicesInput{
+			Cluster:  aws.String(cluster),
+			Services: []*string{aws.String(id)},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output.Services) < 1 {
+			return nil, "", fmt.Errorf("ECS Service (%s) not found", id)
+		}
+
+		service := output.Services[0]
+		if len(service.Deployments) != 1 {
+			log.Printf("[DEBUG] ECS Service (%s) has %d deployments", id, len(service.Deployments))
+			return service, "PENDING", nil
+		}
+
+		deployment := service.Deployments[0]
+		log.Printf("[DEBUG] ECS Service (%s) %s deployment running %d of %d tasks", id, aws.StringValue(deployment.Status),
+			aws.Int64Value(deployment.RunningCount), aws.Int64Value(deployment.DesiredCount))
+		if aws.StringValue(deployment.Status) != "PRIMARY" || aws.Int64Value(deployment.RunningCount) != aws.Int64Value(deployment.DesiredCount) {
+			return service, "PENDING", nil
+		}
+
+		return service, "STEADY", nil
+	}
+}
+
+// ecsServiceLatestEvents returns up to n of the service's most recent events,
+// newest first.
+func ecsServiceLatestEvents(conn *ecs.ECS, id, cluster string, n int) ([]string, error) {
+	output, err := conn.DescribeServices(&ecs.DescribeServicesInput{
+		Cluster:  aws.String(cluster),
+		Services: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Services) < 1 {
+		return nil, nil
+	}
+
+	// ECS returns events newest first
+	var events []string
+	for _, event := range output.Services[0].Events {
+		if len(events) == n {
+			break
+		}
+		events = append(events, fmt.Sprintf("%s: %s", aws.TimeValue(event.CreatedAt).UTC().Format(time.RFC3339), aws.StringValue(event.Message)))
+	}
+
+	return events, nil
+}
+
 func resourceAwsEcsLoadBalancerHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
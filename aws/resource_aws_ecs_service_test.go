@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -104,6 +106,63 @@ func testSweepEcsServices(region string) error {
 	return nil
 }
 
+const testMockAwsEcsServiceArn = "arn:aws:ecs:us-west-2:123456789012:service/tf-mock"
+
+func TestEcsServiceSteadyStateRefreshFunc(t *testing.T) {
+	m := newTestMockAws(t, "ecs")
+	defer m.Close(t)
+
+	m.On("ecs", "DescribeServices").
+		Respond(`{"services":[{"serviceArn":"` + testMockAwsEcsServiceArn + `","deployments":[{"status":"PRIMARY","desiredCount":2,"runningCount":2},{"status":"ACTIVE","desiredCount":0,"runningCount":1}]}]}`).
+		Respond(`{"services":[{"serviceArn":"` + testMockAwsEcsServiceArn + `","deployments":[{"status":"PRIMARY","desiredCount":2,"runningCount":1}]}]}`).
+		Respond(`{"services":[{"serviceArn":"` + testMockAwsEcsServiceArn + `","deployments":[{"status":"PRIMARY","desiredCount":2,"runningCount":2}]}]}`)
+
+	refresh := ecsServiceSteadyStateRefreshFunc(m.client.ecsconn, testMockAwsEcsServiceArn, "tf-mock")
+
+	for i, want := range []string{"PENDING", "PENDING", "STEADY"} {
+		_, state, err := refresh()
+		if err != nil {
+			t.Fatalf("refresh %d: error describing ECS Service: %s", i, err)
+		}
+
+		if state != want {
+			t.Errorf("refresh %d: expected state %q, got %q", i, want, state)
+		}
+	}
+}
+
+func TestWaitForEcsServiceSteadyState_timeout(t *testing.T) {
+	m := newTestMockAws(t, "ecs")
+	defer m.Close(t)
+
+	m.On("ecs", "DescribeServices").
+		Respond(`{"services":[{"serviceArn":"` + testMockAwsEcsServiceArn + `","deployments":[{"status":"PRIMARY","desiredCount":1,"runningCount":0}],` +
+			`"events":[{"id":"2","createdAt":1577836860,"message":"(service tf-mock) has started 1 tasks: (task 2)."},` +
+			`{"id":"1","createdAt":1577836800,"message":"(service tf-mock) has started 1 tasks: (task 1)."}]}]}`)
+
+	err := waitForEcsServiceSteadyState(m.client.ecsconn, testMockAwsEcsServiceArn, "tf-mock", 1*time.Second)
+	if err == nil {
+		t.Fatal("expected error waiting for ECS Service steady state")
+	}
+
+	if !strings.Contains(err.Error(), "2020-01-01T00:01:00Z: (service tf-mock) has started 1 tasks: (task 2).") {
+		t.Errorf("expected error to include the latest service event, got: %s", err)
+	}
+}
+
+func TestWaitForEcsServiceSteadyState_describeError(t *testing.T) {
+	m := newTestMockAws(t, "ecs")
+	defer m.Close(t)
+
+	m.On("ecs", "DescribeServices").
+		RespondError(http.StatusBadRequest, ecs.ErrCodeClusterNotFoundException, "Cluster not found.")
+
+	err := waitForEcsServiceSteadyState(m.client.ecsconn, testMockAwsEcsServiceArn, "tf-mock", 1*time.Minute)
+	if !isAWSErr(err, ecs.ErrCodeClusterNotFoundException, "") {
+		t.Fatalf("expected ClusterNotFoundException, got: %v", err)
+	}
+}
+
 func TestAccAWSEcsService_withARN(t *testing.T) {
 	var service ecs.Service
 	rString := acctest.RandString(8)
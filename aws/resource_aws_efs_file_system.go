@@ -25,6 +25,7 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -82,7 +83,8 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 				Optional: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"throughput_mode": {
 				Type:     schema.TypeString,
@@ -203,7 +205,7 @@ func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsEFS(conn, d)
 		if err != nil {
 			return fmt.Errorf("Error setting EC2 tags for EFS file system (%q): %s",
@@ -258,7 +260,7 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	err = d.Set("tags", tagsToMapEFS(tags))
+	err = setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapEFS(tags, meta.(*AWSClient).ignoreTagsConfig))
 	if err != nil {
 		return err
 	}
@@ -23,6 +23,7 @@ func resourceAwsEip() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Read:   schema.DefaultTimeout(15 * time.Minute),
@@ -97,7 +98,8 @@ func resourceAwsEip() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -141,7 +143,7 @@ func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] EIP ID: %s (domain: %v)", d.Id(), *allocResp.Domain)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(ec2conn, d); err != nil {
 			return fmt.Errorf("Error creating EIP tags: %s", err)
 		}
@@ -269,7 +271,7 @@ func resourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
 		d.SetId(*address.AllocationId)
 	}
 
-	d.Set("tags", tagsToMap(address.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(address.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -349,7 +351,7 @@ func resourceAwsEipUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(ec2conn, d); err != nil {
 			return fmt.Errorf("Error updating EIP tags: %s", err)
 		}
@@ -21,6 +21,7 @@ func resourceAwsElasticBeanstalkApplication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -62,7 +63,8 @@ func resourceAwsElasticBeanstalkApplication() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -79,7 +81,7 @@ func resourceAwsElasticBeanstalkApplicationCreate(d *schema.ResourceData, meta i
 	req := &elasticbeanstalk.CreateApplicationInput{
 		ApplicationName: aws.String(name),
 		Description:     aws.String(description),
-		Tags:            tagsFromMapBeanstalk(d.Get("tags").(map[string]interface{})),
+		Tags:            tagsFromMapBeanstalk(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	app, err := beanstalkConn.CreateApplication(req)
@@ -241,7 +243,7 @@ func resourceAwsElasticBeanstalkApplicationRead(d *schema.ResourceData, meta int
 		d.Set("appversion_lifecycle", flattenResourceLifecycleConfig(app.ResourceLifecycleConfig))
 	}
 
-	if err := saveTagsBeanstalk(conn, d, aws.StringValue(app.ApplicationArn)); err != nil {
+	if err := saveTagsBeanstalk(conn, d, aws.StringValue(app.ApplicationArn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return fmt.Errorf("error saving tags for %s: %s", d.Id(), err)
 	}
 
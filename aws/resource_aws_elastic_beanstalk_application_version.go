@@ -13,10 +13,11 @@ import (
 
 func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsElasticBeanstalkApplicationVersionCreate,
-		Read:   resourceAwsElasticBeanstalkApplicationVersionRead,
-		Update: resourceAwsElasticBeanstalkApplicationVersionUpdate,
-		Delete: resourceAwsElasticBeanstalkApplicationVersionDelete,
+		Create:        resourceAwsElasticBeanstalkApplicationVersionCreate,
+		Read:          resourceAwsElasticBeanstalkApplicationVersionRead,
+		Update:        resourceAwsElasticBeanstalkApplicationVersionUpdate,
+		Delete:        resourceAwsElasticBeanstalkApplicationVersionDelete,
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"application": {
@@ -52,7 +53,8 @@ func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -76,7 +78,7 @@ func resourceAwsElasticBeanstalkApplicationVersionCreate(d *schema.ResourceData,
 		Description:     aws.String(description),
 		SourceBundle:    &s3Location,
 		VersionLabel:    aws.String(name),
-		Tags:            tagsFromMapBeanstalk(d.Get("tags").(map[string]interface{})),
+		Tags:            tagsFromMapBeanstalk(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Printf("[DEBUG] Elastic Beanstalk Application Version create opts: %s", createOpts)
@@ -121,7 +123,7 @@ func resourceAwsElasticBeanstalkApplicationVersionRead(d *schema.ResourceData, m
 		return err
 	}
 
-	if err := saveTagsBeanstalk(conn, d, aws.StringValue(resp.ApplicationVersions[0].ApplicationVersionArn)); err != nil {
+	if err := saveTagsBeanstalk(conn, d, aws.StringValue(resp.ApplicationVersions[0].ApplicationVersionArn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return fmt.Errorf("error saving tags for %s: %s", d.Id(), err)
 	}
 
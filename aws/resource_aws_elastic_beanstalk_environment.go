@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsElasticBeanstalkOptionSetting() *schema.Resource {
@@ -51,6 +52,7 @@ func resourceAwsElasticBeanstalkEnvironment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsElasticBeanstalkEnvironmentMigrateState,
@@ -200,7 +202,8 @@ func resourceAwsElasticBeanstalkEnvironment() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -222,12 +225,12 @@ func resourceAwsElasticBeanstalkEnvironmentCreate(d *schema.ResourceData, meta i
 
 	// TODO set tags
 	// Note: at time of writing, you cannot view or edit Tags after creation
-	// d.Set("tags", tagsToMap(instance.Tags))
+	// setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(instance.Tags))
 	createOpts := elasticbeanstalk.CreateEnvironmentInput{
 		EnvironmentName: aws.String(name),
 		ApplicationName: aws.String(app),
 		OptionSettings:  extractOptionSettings(settings),
-		Tags:            tagsFromMapBeanstalk(d.Get("tags").(map[string]interface{})),
+		Tags:            tagsFromMapBeanstalk(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if desc != "" {
@@ -475,17 +478,15 @@ func resourceAwsElasticBeanstalkEnvironmentUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
-		oldTags := tagsFromMapBeanstalk(o.(map[string]interface{}))
-		newTags := tagsFromMapBeanstalk(n.(map[string]interface{}))
-
-		tagsToAdd, tagNamesToRemove := diffTagsBeanstalk(oldTags, newTags)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
 
 		updateTags := elasticbeanstalk.UpdateTagsForResourceInput{
 			ResourceArn:  aws.String(d.Get("arn").(string)),
-			TagsToAdd:    tagsToAdd,
-			TagsToRemove: tagNamesToRemove,
+			TagsToAdd:    oldTags.Updated(newTags).IgnoreAws().ElasticbeanstalkTags(),
+			TagsToRemove: aws.StringSlice(oldTags.Removed(newTags).IgnoreAws().Keys()),
 		}
 
 		// Get the current time to filter getBeanstalkEnvironmentErrors messages
@@ -658,7 +659,7 @@ func resourceAwsElasticBeanstalkEnvironmentRead(d *schema.ResourceData, meta int
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapBeanstalk(tags.ResourceTags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapBeanstalk(tags.ResourceTags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
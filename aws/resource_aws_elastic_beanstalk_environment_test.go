@@ -743,7 +743,7 @@ func testAccCheckBeanstalkEnvTagsMatch(env *elasticbeanstalk.EnvironmentDescript
 			return err
 		}
 
-		foundTags := tagsToMapBeanstalk(tags.ResourceTags)
+		foundTags := tagsToMapBeanstalk(tags.ResourceTags, nil)
 
 		if !reflect.DeepEqual(foundTags, expectedValue) {
 			return fmt.Errorf("Tag value: %s.  Expected %s", foundTags, expectedValue)
@@ -233,10 +233,12 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
 			func(diff *schema.ResourceDiff, v interface{}) error {
 				// Plan time validation for az_mode
 				// InvalidParameterCombination: Must specify at least two cache nodes in order to specify AZ Mode of 'cross-az'.
@@ -308,7 +310,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 		securityIdSet := d.Get("security_group_ids").(*schema.Set)
 		securityNames := expandStringList(securityNameSet.List())
 		securityIds := expandStringList(securityIdSet.List())
-		tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+		tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}))
 
 		req.CacheSecurityGroupNames = securityNames
 		req.SecurityGroupIds = securityIds
@@ -484,7 +486,7 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 		if len(resp.TagList) > 0 {
 			et = resp.TagList
 		}
-		d.Set("tags", tagsToMapEC(et))
+		setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapEC(et, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	return nil
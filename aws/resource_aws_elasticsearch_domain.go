@@ -28,6 +28,7 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
 			customdiff.ForceNewIf("elasticsearch_version", func(d *schema.ResourceDiff, meta interface{}) bool {
 				newVersion := d.Get("elasticsearch_version").(string)
 				domainName := d.Get("domain_name").(string)
@@ -324,7 +325,8 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -487,14 +489,15 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	// This should mean that if the creation fails (eg because your token expired
 	// whilst the operation is being performed), we still get the required tags on
 	// the resources.
-	tags := tagsFromMapElasticsearchService(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapElasticsearchService(d.Get("tags_all").(map[string]interface{}))
 
 	if err := setTagsElasticsearchService(conn, d, aws.StringValue(out.DomainStatus.ARN)); err != nil {
 		return err
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapElasticsearchService(tags, meta.(*AWSClient).ignoreTagsConfig))
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be created", d.Id())
 	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id())
@@ -638,7 +641,7 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 		est = listOut.TagList
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(est))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapElasticsearchService(est, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -654,6 +657,8 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 
 	d.SetPartial("tags")
 
+	d.SetPartial("tags_all")
+
 	input := elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
 	}
@@ -29,6 +29,7 @@ func resourceAwsElb() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -245,7 +246,8 @@ func resourceAwsElb() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"wait_for_ready_timeout": {
 				Type:         schema.TypeString,
@@ -278,7 +280,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("name", elbName)
 	}
 
-	tags := tagsFromMapELB(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapELB(d.Get("tags_all").(map[string]interface{}))
 	// Provision the elb
 	elbOpts := &elb.CreateLoadBalancerInput{
 		LoadBalancerName: aws.String(elbName),
@@ -341,7 +343,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("security_groups")
 	d.SetPartial("subnets")
 
-	d.Set("tags", tagsToMapELB(tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapELB(tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return resourceAwsElbUpdate(d, meta)
 }
@@ -378,7 +380,39 @@ func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to find ELB: %#v", describeResp.LoadBalancerDescriptions)
 	}
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, describeResp.LoadBalancerDescriptions[0])
+	lb := describeResp.LoadBalancerDescriptions[0]
+
+	if err := flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, lb); err != nil {
+		return err
+	}
+
+	tags, err := describeAwsELbTags(elbconn, lb.LoadBalancerName, meta.(*AWSClient).ignoreTagsConfig)
+	if err != nil {
+		return fmt.Errorf("error describing tags for ELB (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// describeAwsELbTags returns the tags of the named Classic Load Balancer as a map.
+func describeAwsELbTags(conn *elb.ELB, name *string, ignoreConfig *IgnoreTagsConfig) (map[string]string, error) {
+	resp, err := conn.DescribeTags(&elb.DescribeTagsInput{
+		LoadBalancerNames: []*string{name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var et []*elb.Tag
+	if len(resp.TagDescriptions) > 0 {
+		et = resp.TagDescriptions[0].Tags
+	}
+
+	return tagsToMapELB(et, ignoreConfig), nil
 }
 
 // flattenAwsELbResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
@@ -457,19 +491,6 @@ func flattenAwsELbResource(d *schema.ResourceData, ec2conn *ec2.EC2, elbconn *el
 		}
 	}
 
-	resp, err := elbconn.DescribeTags(&elb.DescribeTagsInput{
-		LoadBalancerNames: []*string{lb.LoadBalancerName},
-	})
-	if err != nil {
-		return fmt.Errorf("error describing tags for ELB (%s): %s", d.Id(), err)
-	}
-
-	var et []*elb.Tag
-	if len(resp.TagDescriptions) > 0 {
-		et = resp.TagDescriptions[0].Tags
-	}
-	d.Set("tags", tagsToMapELB(et))
-
 	// There's only one health check, so save that to state as we
 	// currently can
 	if *lb.HealthCheck.Target != "" {
@@ -802,6 +823,8 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetPartial("tags")
+
+	d.SetPartial("tags_all")
 	d.Partial(false)
 
 	return resourceAwsElbRead(d, meta)
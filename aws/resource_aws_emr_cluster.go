@@ -15,11 +15,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEMRCluster() *schema.Resource {
@@ -32,56 +34,59 @@ func resourceAwsEMRCluster() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
-			if diff.HasChange("instance_group") {
-				o, n := diff.GetChange("instance_group")
-				oSet := o.(*schema.Set).List()
-				nSet := n.(*schema.Set).List()
-
-				// Everything in instance group needs to be set to forcenew if the autoscaling policy doesn't change
-				if len(oSet) != len(nSet) {
-					return nil
-				}
-				for _, currInstanceGroup := range oSet {
-					for _, nextInstanceGroup := range nSet {
-						oInstanceGroup := currInstanceGroup.(map[string]interface{})
-						nInstanceGroup := nextInstanceGroup.(map[string]interface{})
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				if diff.HasChange("instance_group") {
+					o, n := diff.GetChange("instance_group")
+					oSet := o.(*schema.Set).List()
+					nSet := n.(*schema.Set).List()
+
+					// Everything in instance group needs to be set to forcenew if the autoscaling policy doesn't change
+					if len(oSet) != len(nSet) {
+						return nil
+					}
+					for _, currInstanceGroup := range oSet {
+						for _, nextInstanceGroup := range nSet {
+							oInstanceGroup := currInstanceGroup.(map[string]interface{})
+							nInstanceGroup := nextInstanceGroup.(map[string]interface{})
 
-						if oInstanceGroup["instance_role"].(string) != nInstanceGroup["instance_role"].(string) || oInstanceGroup["name"].(string) != nInstanceGroup["name"].(string) {
-							continue
-						}
+							if oInstanceGroup["instance_role"].(string) != nInstanceGroup["instance_role"].(string) || oInstanceGroup["name"].(string) != nInstanceGroup["name"].(string) {
+								continue
+							}
 
-						oAutoScalingPolicy := oInstanceGroup["autoscaling_policy"].(string)
-						nAutoScalingPolicy := nInstanceGroup["autoscaling_policy"].(string)
+							oAutoScalingPolicy := oInstanceGroup["autoscaling_policy"].(string)
+							nAutoScalingPolicy := nInstanceGroup["autoscaling_policy"].(string)
 
-						if oAutoScalingPolicy == "" && nAutoScalingPolicy == "" {
-							continue
-						}
+							if oAutoScalingPolicy == "" && nAutoScalingPolicy == "" {
+								continue
+							}
 
-						oJSON, err := structure.NormalizeJsonString(oAutoScalingPolicy)
-						if err != nil {
-							return fmt.Errorf("error reading old json value: %s", err)
-						}
-						nJSON, err := structure.NormalizeJsonString(nAutoScalingPolicy)
-						if err != nil {
-							return fmt.Errorf("error reading new json value: %s", err)
-						}
+							oJSON, err := structure.NormalizeJsonString(oAutoScalingPolicy)
+							if err != nil {
+								return fmt.Errorf("error reading old json value: %s", err)
+							}
+							nJSON, err := structure.NormalizeJsonString(nAutoScalingPolicy)
+							if err != nil {
+								return fmt.Errorf("error reading new json value: %s", err)
+							}
 
-						if oJSON != nJSON {
-							continue
-						}
-						for _, k := range diff.GetChangedKeysPrefix(fmt.Sprintf("instance_group.%d", resourceAwsEMRClusterInstanceGroupHash(oInstanceGroup))) {
-							if strings.HasSuffix(k, ".#") {
-								k = strings.TrimSuffix(k, ".#")
+							if oJSON != nJSON {
+								continue
 							}
-							diff.ForceNew(k)
+							for _, k := range diff.GetChangedKeysPrefix(fmt.Sprintf("instance_group.%d", resourceAwsEMRClusterInstanceGroupHash(oInstanceGroup))) {
+								if strings.HasSuffix(k, ".#") {
+									k = strings.TrimSuffix(k, ".#")
+								}
+								diff.ForceNew(k)
+							}
+							break
 						}
-						break
 					}
 				}
-			}
-			return nil
-		},
+				return nil
+			},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -565,7 +570,8 @@ func resourceAwsEMRCluster() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"configurations": {
 				Type:          schema.TypeString,
 				ForceNew:      true,
@@ -848,7 +854,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		steps := v.([]interface{})
 		params.Steps = expandEmrStepConfigs(steps)
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tagsIn := v.(map[string]interface{})
 		params.Tags = expandTags(tagsIn)
 	}
@@ -1029,7 +1035,7 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("log_uri", cluster.LogUri)
 	d.Set("master_public_dns", cluster.MasterPublicDnsName)
 	d.Set("visible_to_all_users", cluster.VisibleToAllUsers)
-	d.Set("tags", tagsToMapEMR(cluster.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapEMR(cluster.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	d.Set("ebs_root_volume_size", cluster.EbsRootVolumeSize)
 	d.Set("scale_down_behavior", cluster.ScaleDownBehavior)
 	d.Set("termination_protection", cluster.TerminationProtected)
@@ -1328,6 +1334,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -1751,68 +1758,24 @@ func expandTags(m map[string]interface{}) []*emr.Tag {
 	return result
 }
 
-func tagsToMapEMR(ts []*emr.Tag) map[string]string {
+func tagsToMapEMR(ts []*emr.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
+		if ignoreConfig.Ignored(*t.Key) {
+			continue
+		}
+
 		result[*t.Key] = *t.Value
 	}
 
 	return result
 }
 
-func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*emr.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return expandTags(create), remove
-}
-
 func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEMR(expandTags(o), expandTags(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.RemoveTags(&emr.RemoveTagsInput{
-				ResourceId: aws.String(d.Id()),
-				TagKeys:    k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTags(&emr.AddTagsInput{
-				ResourceId: aws.String(d.Id()),
-				Tags:       create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.EmrUpdateTags(conn, d.Id(), o, n)
 	}
 
 	return nil
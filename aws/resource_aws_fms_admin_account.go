@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsFmsAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsAdminAccountCreate,
+		Read:   resourceAwsFmsAdminAccountRead,
+		Delete: resourceAwsFmsAdminAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsFmsAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok && v.(string) != "" {
+		accountID = v.(string)
+	}
+
+	input := &fms.AssociateAdminAccountInput{
+		AdminAccount: aws.String(accountID),
+	}
+
+	log.Printf("[DEBUG] Associating FMS Admin Account: %s", input)
+	if _, err := conn.AssociateAdminAccount(input); err != nil {
+		return fmt.Errorf("error associating FMS Admin Account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			fms.AccountRoleStatusCreating,
+			fms.AccountRoleStatusDeleted, // Returned while the association is not yet visible
+			fms.AccountRoleStatusPendingDeletion,
+		},
+		Target:  []string{fms.AccountRoleStatusReady},
+		Refresh: refreshFmsAdminAccountRoleStatus(conn, accountID),
+		Timeout: 5 * time.Minute,
+		Delay:   10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for FMS Admin Account (%s) association: %s", accountID, err)
+	}
+
+	return resourceAwsFmsAdminAccountRead(d, meta)
+}
+
+func resourceAwsFmsAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || aws.StringValue(output.AdminAccount) != d.Id() {
+		log.Printf("[WARN] FMS Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(output.RoleStatus) == fms.AccountRoleStatusDeleted {
+		log.Printf("[WARN] FMS Admin Account (%s) is %s, removing from state", d.Id(), fms.AccountRoleStatusDeleted)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", output.AdminAccount)
+
+	return nil
+}
+
+func resourceAwsFmsAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	log.Printf("[DEBUG] Disassociating FMS Admin Account: %s", d.Id())
+	_, err := conn.DisassociateAdminAccount(&fms.DisassociateAdminAccountInput{})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating FMS Admin Account (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			fms.AccountRoleStatusDeleting,
+			fms.AccountRoleStatusPendingDeletion,
+			fms.AccountRoleStatusReady,
+		},
+		Target:  []string{fms.AccountRoleStatusDeleted},
+		Refresh: refreshFmsAdminAccountRoleStatus(conn, d.Id()),
+		Timeout: 10 * time.Minute,
+		Delay:   10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for FMS Admin Account (%s) disassociation: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func refreshFmsAdminAccountRoleStatus(conn *fms.FMS, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+		// The admin account is eventually removed entirely after disassociation.
+		// Return a non-nil result so the waiter does not treat it as not found.
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			return &fms.GetAdminAccountOutput{}, fms.AccountRoleStatusDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || aws.StringValue(output.AdminAccount) != accountID {
+			return &fms.GetAdminAccountOutput{}, fms.AccountRoleStatusDeleted, nil
+		}
+
+		return output, aws.StringValue(output.RoleStatus), nil
+	}
+}
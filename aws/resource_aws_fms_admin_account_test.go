@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsFmsAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_fms_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccFmsAdminAccountRegionPreCheck(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFmsAdminAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsAdminAccountExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccFmsAdminAccountRegionPreCheck skips tests outside us-east-1,
+// the only region which accepts FMS administrator account associations.
+func testAccFmsAdminAccountRegionPreCheck(t *testing.T) {
+	if region := testAccGetRegion(); region != "us-east-1" {
+		t.Skipf("skipping tests; FMS administrator account associations are only supported in us-east-1, current region: %s", region)
+	}
+}
+
+func testAccCheckAwsFmsAdminAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Admin Account ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.AdminAccount) != rs.Primary.ID {
+			return fmt.Errorf("FMS Admin Account (%s) not found, current admin account: %s", rs.Primary.ID, aws.StringValue(output.AdminAccount))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsFmsAdminAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_admin_account" {
+			continue
+		}
+
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.AdminAccount) == rs.Primary.ID && aws.StringValue(output.RoleStatus) != fms.AccountRoleStatusDeleted {
+			return fmt.Errorf("FMS Admin Account (%s) still exists with status: %s", rs.Primary.ID, aws.StringValue(output.RoleStatus))
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsFmsAdminAccountConfig() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["fms.amazonaws.com"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_fms_admin_account" "test" {
+  account_id = "${aws_organizations_organization.test.master_account_id}"
+}
+`
+}
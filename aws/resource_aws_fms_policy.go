@@ -0,0 +1,375 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// fmsPolicyResourceTypeList is the ResourceType value which indicates
+// the policy applies to the resource types in ResourceTypeList.
+const fmsPolicyResourceTypeList = "ResourceTypeList"
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsFmsPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_all_policy_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exclude_map": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAwsAccountId,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"include_map": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAwsAccountId,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_tags": tagsSchema(),
+			"resource_type_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"AWS::ApiGateway::Stage",
+						"AWS::CloudFront::Distribution",
+						"AWS::EC2::EIP",
+						"AWS::ElasticLoadBalancingV2::LoadBalancer",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								fms.SecurityServiceTypeShieldAdvanced,
+								fms.SecurityServiceTypeWaf,
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: expandFmsPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Creating FMS Policy: %s", input)
+	output, err := conn.PutPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy: %s", err)
+	}
+
+	if output == nil || output.Policy == nil {
+		return fmt.Errorf("error creating FMS Policy: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Policy == nil {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", output.PolicyArn)
+
+	if err := d.Set("exclude_map", flattenFmsPolicyMap(policy.ExcludeMap)); err != nil {
+		return fmt.Errorf("error setting exclude_map: %s", err)
+	}
+
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+
+	if err := d.Set("include_map", flattenFmsPolicyMap(policy.IncludeMap)); err != nil {
+		return fmt.Errorf("error setting include_map: %s", err)
+	}
+
+	d.Set("name", policy.PolicyName)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+
+	if err := d.Set("resource_tags", flattenFmsResourceTags(policy.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %s", err)
+	}
+
+	resourceTypeList := aws.StringValueSlice(policy.ResourceTypeList)
+	if aws.StringValue(policy.ResourceType) != fmsPolicyResourceTypeList {
+		resourceTypeList = []string{aws.StringValue(policy.ResourceType)}
+	}
+
+	if err := d.Set("resource_type_list", resourceTypeList); err != nil {
+		return fmt.Errorf("error setting resource_type_list: %s", err)
+	}
+
+	if err := d.Set("security_service_policy_data", flattenFmsSecurityServicePolicyData(policy.SecurityServicePolicyData)); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	policy := expandFmsPolicy(d)
+	policy.PolicyId = aws.String(d.Id())
+	policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+	input := &fms.PutPolicyInput{
+		Policy: policy,
+	}
+
+	log.Printf("[DEBUG] Updating FMS Policy: %s", input)
+	if _, err := conn.PutPolicy(input); err != nil {
+		return fmt.Errorf("error updating FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.DeletePolicyInput{
+		DeleteAllPolicyResources: aws.Bool(d.Get("delete_all_policy_resources").(bool)),
+		PolicyId:                 aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting FMS Policy: %s", input)
+	_, err := conn.DeletePolicy(input)
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("delete_all_policy_resources", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandFmsPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		ExcludeMap:          expandFmsPolicyMap(d.Get("exclude_map").([]interface{})),
+		ExcludeResourceTags: aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		IncludeMap:          expandFmsPolicyMap(d.Get("include_map").([]interface{})),
+		PolicyName:          aws.String(d.Get("name").(string)),
+		RemediationEnabled:  aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:        expandFmsResourceTags(d.Get("resource_tags").(map[string]interface{})),
+	}
+
+	// A single resource type is passed in ResourceType, multiple resource
+	// types require ResourceType to be set to the literal "ResourceTypeList".
+	resourceTypeList := expandStringSet(d.Get("resource_type_list").(*schema.Set))
+	if len(resourceTypeList) == 1 {
+		policy.ResourceType = resourceTypeList[0]
+	} else {
+		policy.ResourceType = aws.String(fmsPolicyResourceTypeList)
+		policy.ResourceTypeList = resourceTypeList
+	}
+
+	if v, ok := d.GetOk("security_service_policy_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+
+		policy.SecurityServicePolicyData = &fms.SecurityServicePolicyData{
+			Type: aws.String(m["type"].(string)),
+		}
+
+		if v, ok := m["managed_service_data"].(string); ok && v != "" {
+			policy.SecurityServicePolicyData.ManagedServiceData = aws.String(v)
+		}
+	}
+
+	return policy
+}
+
+func expandFmsPolicyMap(l []interface{}) map[string][]*string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	policyMap := make(map[string][]*string)
+
+	if v, ok := m["account"].(*schema.Set); ok && v.Len() > 0 {
+		policyMap[fms.CustomerPolicyScopeIdTypeAccount] = expandStringSet(v)
+	}
+
+	return policyMap
+}
+
+func flattenFmsPolicyMap(policyMap map[string][]*string) []interface{} {
+	if len(policyMap) == 0 {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"account": schema.NewSet(schema.HashString, flattenStringList(policyMap[fms.CustomerPolicyScopeIdTypeAccount])),
+	}
+
+	return []interface{}{m}
+}
+
+func expandFmsResourceTags(m map[string]interface{}) []*fms.ResourceTag {
+	if len(m) == 0 {
+		return nil
+	}
+
+	resourceTags := make([]*fms.ResourceTag, 0, len(m))
+
+	for k, v := range m {
+		resourceTags = append(resourceTags, &fms.ResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return resourceTags
+}
+
+func flattenFmsResourceTags(resourceTags []*fms.ResourceTag) map[string]interface{} {
+	m := make(map[string]interface{}, len(resourceTags))
+
+	for _, resourceTag := range resourceTags {
+		if resourceTag == nil {
+			continue
+		}
+
+		m[aws.StringValue(resourceTag.Key)] = aws.StringValue(resourceTag.Value)
+	}
+
+	return m
+}
+
+func flattenFmsSecurityServicePolicyData(data *fms.SecurityServicePolicyData) []interface{} {
+	if data == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"managed_service_data": aws.StringValue(data.ManagedServiceData),
+		"type":                 aws.StringValue(data.Type),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsFmsPolicy_basic(t *testing.T) {
+	var policy fms.Policy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccFmsAdminAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFmsPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName, &policy),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fms", regexp.MustCompile(`policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "delete_all_policy_resources", "true"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", fms.SecurityServiceTypeWaf),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsFmsPolicy_ResourceTags(t *testing.T) {
+	var policy fms.Policy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccFmsAdminAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFmsPolicyConfigResourceTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFmsPolicyConfigResourceTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsFmsPolicy_IncludeMap(t *testing.T) {
+	var policy fms.Policy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccFmsAdminAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFmsPolicyConfigIncludeMap(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "include_map.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include_map.0.account.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccFmsAdminAccountPreCheck skips tests unless the current account is
+// the FMS administrator account, which is required to manage FMS policies.
+func testAccFmsAdminAccountPreCheck(t *testing.T) {
+	client := testAccProvider.Meta().(*AWSClient)
+
+	output, err := client.fmsconn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		t.Skip("skipping tests; this AWS account must be the FMS administrator account")
+	}
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping tests: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("error reading FMS Admin Account: %s", err)
+	}
+
+	if aws.StringValue(output.AdminAccount) != client.accountid {
+		t.Skip("skipping tests; this AWS account must be the FMS administrator account")
+	}
+}
+
+func testAccCheckAwsFmsPolicyExists(n string, v *fms.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+		output, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Policy == nil {
+			return fmt.Errorf("FMS Policy (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output.Policy
+
+		return nil
+	}
+}
+
+func testAccCheckAwsFmsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_policy" {
+			continue
+		}
+
+		output, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.Policy != nil {
+			return fmt.Errorf("FMS Policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsFmsPolicyConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule_group" "test" {
+  metric_name = "MyTest"
+  name        = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsFmsPolicyConfig(rName string) string {
+	return testAccAwsFmsPolicyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  resource_type_list    = ["AWS::ElasticLoadBalancingV2::LoadBalancer"]
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [{\"id\":\"${aws_wafregional_rule_group.test.id}\", \"overrideAction\" : {\"type\": \"COUNT\"}}],\"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName)
+}
+
+func testAccAwsFmsPolicyConfigIncludeMap(rName string) string {
+	return testAccAwsFmsPolicyConfigBase(rName) + fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  resource_type_list    = ["AWS::ElasticLoadBalancingV2::LoadBalancer"]
+
+  include_map {
+    account = ["${data.aws_caller_identity.current.account_id}"]
+  }
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [{\"id\":\"${aws_wafregional_rule_group.test.id}\", \"overrideAction\" : {\"type\": \"COUNT\"}}],\"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName)
+}
+
+func testAccAwsFmsPolicyConfigResourceTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsFmsPolicyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = true
+  name                  = %[1]q
+  resource_type_list    = ["AWS::ElasticLoadBalancingV2::LoadBalancer"]
+
+  resource_tags = {
+    %[2]q = %[3]q
+  }
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [{\"id\":\"${aws_wafregional_rule_group.test.id}\", \"overrideAction\" : {\"type\": \"COUNT\"}}],\"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsFmsPolicyConfigResourceTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAwsFmsPolicyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = true
+  name                  = %[1]q
+  resource_type_list    = ["AWS::ElasticLoadBalancingV2::LoadBalancer"]
+
+  resource_tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [{\"id\":\"${aws_wafregional_rule_group.test.id}\", \"overrideAction\" : {\"type\": \"COUNT\"}}],\"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsFsxLustreFileSystem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFsxLustreFileSystemCreate,
+		Read:   resourceAwsFsxLustreFileSystemRead,
+		Update: resourceAwsFsxLustreFileSystemUpdate,
+		Delete: resourceAwsFsxLustreFileSystemDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 900),
+					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+				),
+			},
+			"import_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 900),
+					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+				),
+			},
+			"imported_file_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 512000),
+			},
+			"network_interface_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"storage_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(3600),
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"weekly_maintenance_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-7]:([01]\d|2[0-3]):([0-5]\d)$`), "must be in the format d:HH:MM"),
+			},
+		},
+	}
+}
+
+func resourceAwsFsxLustreFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	input := &fsx.CreateFileSystemInput{
+		ClientRequestToken:  aws.String(resource.UniqueId()),
+		FileSystemType:      aws.String(fsx.FileSystemTypeLustre),
+		LustreConfiguration: &fsx.CreateFileSystemLustreConfiguration{},
+		StorageCapacity:     aws.Int64(int64(d.Get("storage_capacity").(int))),
+		SubnetIds:           expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("export_path"); ok {
+		input.LustreConfiguration.ExportPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("import_path"); ok {
+		input.LustreConfiguration.ImportPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("imported_file_chunk_size"); ok {
+		input.LustreConfiguration.ImportedFileChunkSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws(); len(v) > 0 {
+		input.Tags = v.FsxTags()
+	}
+
+	if v, ok := d.GetOk("weekly_maintenance_start_time"); ok {
+		input.LustreConfiguration.WeeklyMaintenanceStartTime = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating FSx Lustre File System: %s", input)
+	result, err := conn.CreateFileSystem(input)
+	if err != nil {
+		return fmt.Errorf("error creating FSx Lustre File System: %s", err)
+	}
+
+	d.SetId(aws.StringValue(result.FileSystem.FileSystemId))
+
+	if err := waitForFsxFileSystemCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for FSx Lustre File System (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsFsxLustreFileSystemRead(d, meta)
+}
+
+func resourceAwsFsxLustreFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.FsxUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FSx Lustre File System (%s) tags: %s", d.Get("arn").(string), err)
+		}
+	}
+
+	if d.HasChange("weekly_maintenance_start_time") {
+		input := &fsx.UpdateFileSystemInput{
+			ClientRequestToken: aws.String(resource.UniqueId()),
+			FileSystemId:       aws.String(d.Id()),
+			LustreConfiguration: &fsx.UpdateFileSystemLustreConfiguration{
+				WeeklyMaintenanceStartTime: aws.String(d.Get("weekly_maintenance_start_time").(string)),
+			},
+		}
+
+		_, err := conn.UpdateFileSystem(input)
+		if err != nil {
+			return fmt.Errorf("error updating FSx Lustre File System (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFsxLustreFileSystemRead(d, meta)
+}
+
+func resourceAwsFsxLustreFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	filesystem, err := describeFsxFileSystem(conn, d.Id())
+
+	if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+		log.Printf("[WARN] FSx Lustre File System (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FSx Lustre File System (%s): %s", d.Id(), err)
+	}
+
+	if filesystem == nil {
+		log.Printf("[WARN] FSx Lustre File System (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if filesystem.WindowsConfiguration != nil {
+		return fmt.Errorf("expected FSx Lustre File System, found FSx Windows File System: %s", d.Id())
+	}
+
+	if filesystem.LustreConfiguration == nil {
+		return fmt.Errorf("error describing FSx Lustre File System (%s): empty Lustre configuration", d.Id())
+	}
+
+	if filesystem.LustreConfiguration.DataRepositoryConfiguration == nil {
+		// Initialize an empty structure to simplify d.Set() handling
+		filesystem.LustreConfiguration.DataRepositoryConfiguration = &fsx.DataRepositoryConfiguration{}
+	}
+
+	d.Set("arn", filesystem.ResourceARN)
+	d.Set("dns_name", filesystem.DNSName)
+	d.Set("export_path", filesystem.LustreConfiguration.DataRepositoryConfiguration.ExportPath)
+	d.Set("import_path", filesystem.LustreConfiguration.DataRepositoryConfiguration.ImportPath)
+	d.Set("imported_file_chunk_size", filesystem.LustreConfiguration.DataRepositoryConfiguration.ImportedFileChunkSize)
+
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(filesystem.NetworkInterfaceIds)); err != nil {
+		return fmt.Errorf("error setting network_interface_ids: %s", err)
+	}
+
+	d.Set("owner_id", filesystem.OwnerId)
+	d.Set("storage_capacity", filesystem.StorageCapacity)
+
+	if err := d.Set("subnet_ids", aws.StringValueSlice(filesystem.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	tags := keyvaluetags.FsxKeyValueTags(filesystem.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig).Map()
+
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags); err != nil {
+		return err
+	}
+
+	d.Set("vpc_id", filesystem.VpcId)
+	d.Set("weekly_maintenance_start_time", filesystem.LustreConfiguration.WeeklyMaintenanceStartTime)
+
+	return nil
+}
+
+func resourceAwsFsxLustreFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	input := &fsx.DeleteFileSystemInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		FileSystemId:       aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting FSx Lustre File System: %s", d.Id())
+	_, err := conn.DeleteFileSystem(input)
+
+	if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FSx Lustre File System (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForFsxFileSystemDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for FSx Lustre File System (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/glacier"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsGlacierVault() *schema.Resource {
@@ -25,6 +26,7 @@ func resourceAwsGlacierVault() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -84,7 +86,8 @@ func resourceAwsGlacierVault() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -151,11 +154,11 @@ func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("location", location)
 
-	tags, err := getGlacierVaultTags(glacierconn, d.Id())
+	tags, err := getGlacierVaultTags(glacierconn, d.Id(), meta.(*AWSClient).ignoreTagsConfig)
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tags)
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags)
 
 	log.Printf("[DEBUG] Getting the access_policy for Vault %s", d.Id())
 	pol, err := glacierconn.GetVaultAccessPolicy(&glacier.GetVaultAccessPolicyInput{
@@ -273,8 +276,8 @@ func resourceAwsGlacierVaultPolicyUpdate(glacierconn *glacier.Glacier, d *schema
 }
 
 func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffGlacierVaultTags(mapGlacierVaultTags(o), mapGlacierVaultTags(n))
@@ -338,7 +341,7 @@ func diffGlacierVaultTags(oldTags, newTags map[string]string) (map[string]string
 	return create, remove
 }
 
-func getGlacierVaultTags(glacierconn *glacier.Glacier, vaultName string) (map[string]string, error) {
+func getGlacierVaultTags(glacierconn *glacier.Glacier, vaultName string, ignoreConfig *IgnoreTagsConfig) (map[string]string, error) {
 	request := &glacier.ListTagsForVaultInput{
 		VaultName: aws.String(vaultName),
 	}
@@ -351,16 +354,7 @@ func getGlacierVaultTags(glacierconn *glacier.Glacier, vaultName string) (map[st
 		return nil, err
 	}
 
-	return glacierVaultTagsToMap(response.Tags), nil
-}
-
-func glacierVaultTagsToMap(responseTags map[string]*string) map[string]string {
-	results := make(map[string]string, len(responseTags))
-	for k, v := range responseTags {
-		results[k] = *v
-	}
-
-	return results
+	return keyvaluetags.GlacierKeyValueTags(response.Tags).IgnoreAws().IgnoreConfig(ignoreConfig).Map(), nil
 }
 
 func glacierVaultTagsFromMap(responseTags map[string]string) map[string]*string {
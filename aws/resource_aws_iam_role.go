@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsIamRole() *schema.Resource {
@@ -24,6 +25,7 @@ func resourceAwsIamRole() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsIamRoleImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -121,7 +123,8 @@ func resourceAwsIamRole() *schema.Resource {
 				ValidateFunc: validation.IntBetween(3600, 43200),
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -162,7 +165,7 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		request.PermissionsBoundary = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		request.Tags = tagsFromMapIAM(v.(map[string]interface{}))
 	}
 
@@ -221,7 +224,7 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("permissions_boundary", role.PermissionsBoundary.PermissionsBoundaryArn)
 	}
 	d.Set("unique_id", role.RoleId)
-	if err := d.Set("tags", tagsToMapIAM(role.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapIAM(role.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -305,17 +308,18 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		c, r := diffTagsIAM(tagsFromMapIAM(o), tagsFromMapIAM(n))
+		o, n := d.GetChange("tags_all")
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		c := oldTags.Updated(newTags).IgnoreAws()
+		r := oldTags.Removed(newTags).IgnoreAws()
 
 		if len(r) > 0 {
 			_, err := iamconn.UntagRole(&iam.UntagRoleInput{
 				RoleName: aws.String(d.Id()),
-				TagKeys:  tagKeysIam(r),
+				TagKeys:  aws.StringSlice(r.Keys()),
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting IAM role tags: %s", err)
@@ -325,7 +329,7 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		if len(c) > 0 {
 			input := &iam.TagRoleInput{
 				RoleName: aws.String(d.Id()),
-				Tags:     c,
+				Tags:     c.IamTags(),
 			}
 			_, err := iamconn.TagRole(input)
 			if err != nil {
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"strings"
 	"testing"
@@ -13,11 +12,13 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
-		Name: "aws_iam_role",
+	addTestSweeper(&testSweeper{
+		Name:        "aws_iam_role",
+		Description: "IAM Role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
 			"aws_cognito_user_pool",
@@ -39,86 +40,66 @@ func init() {
 			"aws_redshift_cluster",
 			"aws_spot_fleet_request",
 		},
-		F: testSweepIamRoles,
+		List: testSweepIamRoles,
+		NamePrefixes: []string{
+			"ecs_instance_role",
+			"ecs_tf",
+			"EMR_AutoScaling_DefaultRole",
+			"iam_emr",
+			"terraform-",
+			"test_role",
+			"tf",
+		},
 	})
 }
 
-func testSweepIamRoles(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).iamconn
-	prefixes := []string{
-		"ecs_instance_role",
-		"ecs_tf",
-		"EMR_AutoScaling_DefaultRole",
-		"iam_emr",
-		"terraform-",
-		"test_role",
-		"tf",
-	}
-	roles := make([]*iam.Role, 0)
+func testSweepIamRoles(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.iamconn
+	var resources []*testSweepResource
 
-	err = conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+	err := conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
 		for _, role := range page.Roles {
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(aws.StringValue(role.RoleName), prefix) {
-					roles = append(roles, role)
-					break
-				}
+			if role == nil {
+				continue
 			}
+
+			rolename := aws.StringValue(role.RoleName)
+
+			resources = append(resources, &testSweepResource{
+				ID:        rolename,
+				Name:      rolename,
+				Tags:      keyvaluetags.IamKeyValueTags(role.Tags).Map(),
+				CreatedAt: role.CreateDate,
+				Delete: func() error {
+					if err := deleteAwsIamRoleInstanceProfiles(conn, rolename); err != nil {
+						return fmt.Errorf("error deleting instance profiles: %s", err)
+					}
+
+					if err := deleteAwsIamRolePolicyAttachments(conn, rolename); err != nil {
+						return fmt.Errorf("error deleting policy attachments: %s", err)
+					}
+
+					if err := deleteAwsIamRolePolicies(conn, rolename); err != nil {
+						return fmt.Errorf("error deleting policies: %s", err)
+					}
+
+					_, err := conn.DeleteRole(&iam.DeleteRoleInput{
+						RoleName: aws.String(rolename),
+					})
+
+					if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+						return nil
+					}
+
+					return err
+				},
+			})
 		}
 
 		return !lastPage
 	})
 
-	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Roles: %s", err)
-	}
-
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
-	}
-
-	for _, role := range roles {
-		rolename := aws.StringValue(role.RoleName)
-		log.Printf("[DEBUG] Deleting IAM Role: %s", rolename)
-
-		if err := deleteAwsIamRoleInstanceProfiles(conn, rolename); err != nil {
-			return fmt.Errorf("error deleting IAM Role (%s) instance profiles: %s", rolename, err)
-		}
-
-		if err := deleteAwsIamRolePolicyAttachments(conn, rolename); err != nil {
-			return fmt.Errorf("error deleting IAM Role (%s) policy attachments: %s", rolename, err)
-		}
-
-		if err := deleteAwsIamRolePolicies(conn, rolename); err != nil {
-			return fmt.Errorf("error deleting IAM Role (%s) policies: %s", rolename, err)
-		}
-
-		input := &iam.DeleteRoleInput{
-			RoleName: aws.String(rolename),
-		}
-
-		_, err := conn.DeleteRole(input)
-
-		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-
-		if err != nil {
-			return fmt.Errorf("Error deleting IAM Role (%s): %s", rolename, err)
-		}
-	}
-
-	return nil
+	return resources, err
 }
 
 func TestAccAWSIAMRole_importBasic(t *testing.T) {
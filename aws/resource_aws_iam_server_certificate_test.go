@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"strings"
 	"testing"
 
@@ -14,43 +13,46 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
-		Name: "aws_iam_server_certificate",
-		F:    testSweepIamServerCertificates,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_iam_server_certificate",
+		Description: "IAM Server Certificate",
+		List:        testSweepIamServerCertificates,
 	})
 }
 
-func testSweepIamServerCertificates(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).iamconn
+func testSweepIamServerCertificates(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.iamconn
+	var resources []*testSweepResource
 
-	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
+	err := conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
 		for _, sc := range out.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
+			if sc == nil {
+				continue
+			}
 
-			_, err := conn.DeleteServerCertificate(&iam.DeleteServerCertificateInput{
+			input := &iam.DeleteServerCertificateInput{
 				ServerCertificateName: sc.ServerCertificateName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					*sc.ServerCertificateName, err)
-				continue
 			}
+
+			resources = append(resources, &testSweepResource{
+				ID:        aws.StringValue(sc.ServerCertificateName),
+				Name:      aws.StringValue(sc.ServerCertificateName),
+				CreatedAt: sc.UploadDate,
+				Delete: func() error {
+					_, err := conn.DeleteServerCertificate(input)
+
+					if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+						return nil
+					}
+
+					return err
+				},
+			})
 		}
 		return !lastPage
 	})
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
-	}
 
-	return nil
+	return resources, err
 }
 
 func TestAccAWSIAMServerCertificate_importBasic(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsIamUser() *schema.Resource {
@@ -23,6 +24,7 @@ func resourceAwsIamUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -62,7 +64,8 @@ func resourceAwsIamUser() *schema.Resource {
 				Default:     false,
 				Description: "Delete user even if it has non-Terraform-managed IAM access keys, login profile or MFA devices",
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -81,7 +84,7 @@ func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
 		request.PermissionsBoundary = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := tagsFromMapIAM(v.(map[string]interface{}))
 		request.Tags = tags
 	}
@@ -127,7 +130,7 @@ func resourceAwsIamUserRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("permissions_boundary", output.User.PermissionsBoundary.PermissionsBoundaryArn)
 	}
 	d.Set("unique_id", output.User.UserId)
-	if err := d.Set("tags", tagsToMapIAM(output.User.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapIAM(output.User.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -183,17 +186,18 @@ func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		c, r := diffTagsIAM(tagsFromMapIAM(o), tagsFromMapIAM(n))
+		o, n := d.GetChange("tags_all")
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		c := oldTags.Updated(newTags).IgnoreAws()
+		r := oldTags.Removed(newTags).IgnoreAws()
 
 		if len(r) > 0 {
 			_, err := iamconn.UntagUser(&iam.UntagUserInput{
 				UserName: aws.String(d.Id()),
-				TagKeys:  tagKeysIam(r),
+				TagKeys:  aws.StringSlice(r.Keys()),
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting IAM user tags: %s", err)
@@ -203,7 +207,7 @@ func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		if len(c) > 0 {
 			input := &iam.TagUserInput{
 				UserName: aws.String(d.Id()),
-				Tags:     c,
+				Tags:     c.IamTags(),
 			}
 			_, err := iamconn.TagUser(input)
 			if err != nil {
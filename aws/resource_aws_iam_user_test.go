@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/pquerna/otp/totp"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func TestValidateIamUserName(t *testing.T) {
@@ -55,114 +55,99 @@ func TestValidateIamUserName(t *testing.T) {
 }
 
 func init() {
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
-		Name: "aws_iam_user",
-		F:    testSweepIamUsers,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_iam_user",
+		Description: "IAM User",
+		List:        testSweepIamUsers,
+		NamePrefixes: []string{
+			"test-user",
+			"tf-acc-test",
+		},
 	})
 }
 
-func testSweepIamUsers(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).iamconn
-	prefixes := []string{
-		"test-user",
-		"tf-acc-test",
-	}
-	users := make([]*iam.User, 0)
+func testSweepIamUsers(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.iamconn
+	var resources []*testSweepResource
 
-	err = conn.ListUsersPages(&iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
+	err := conn.ListUsersPages(&iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
 		for _, user := range page.Users {
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(aws.StringValue(user.UserName), prefix) {
-					users = append(users, user)
-					break
-				}
+			if user == nil {
+				continue
 			}
+
+			username := aws.StringValue(user.UserName)
+
+			resources = append(resources, &testSweepResource{
+				ID:        username,
+				Name:      username,
+				Tags:      keyvaluetags.IamKeyValueTags(user.Tags).Map(),
+				CreatedAt: user.CreateDate,
+				Delete: func() error {
+					return testSweepDeleteIamUser(conn, username)
+				},
+			})
 		}
 
 		return !lastPage
 	})
 
-	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM User sweep for %s: %s", region, err)
-		return nil
-	}
+	return resources, err
+}
 
-	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Users: %s", err)
+func testSweepDeleteIamUser(conn *iam.IAM, username string) error {
+	listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(username),
 	}
+	listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPolicies(listAttachedUserPoliciesInput)
 
-	if len(users) == 0 {
-		log.Print("[DEBUG] No IAM Users to sweep")
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
 		return nil
 	}
 
-	for _, user := range users {
-		username := aws.StringValue(user.UserName)
-		log.Printf("[DEBUG] Deleting IAM User: %s", username)
-
-		listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPolicies(listAttachedUserPoliciesInput)
-
-		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-
-		if err != nil {
-			return fmt.Errorf("error listing IAM User (%s) attached policies: %s", username, err)
-		}
-
-		for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
-			policyARN := aws.StringValue(attachedPolicy.PolicyArn)
-
-			log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
+	if err != nil {
+		return fmt.Errorf("error listing attached policies: %s", err)
+	}
 
-			if err := detachPolicyFromUser(conn, username, policyARN); err != nil {
-				return fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %s", username, policyARN, err)
-			}
-		}
+	for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
+		policyARN := aws.StringValue(attachedPolicy.PolicyArn)
 
-		if err := deleteAwsIamUserGroupMemberships(conn, username); err != nil {
-			return fmt.Errorf("error removing IAM User (%s) group memberships: %s", username, err)
-		}
+		log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
 
-		if err := deleteAwsIamUserAccessKeys(conn, username); err != nil {
-			return fmt.Errorf("error removing IAM User (%s) access keys: %s", username, err)
+		if err := detachPolicyFromUser(conn, username, policyARN); err != nil {
+			return fmt.Errorf("error detaching attached policy (%s): %s", policyARN, err)
 		}
+	}
 
-		if err := deleteAwsIamUserSSHKeys(conn, username); err != nil {
-			return fmt.Errorf("error removing IAM User (%s) SSH keys: %s", username, err)
-		}
+	if err := deleteAwsIamUserGroupMemberships(conn, username); err != nil {
+		return fmt.Errorf("error removing group memberships: %s", err)
+	}
 
-		if err := deleteAwsIamUserMFADevices(conn, username); err != nil {
-			return fmt.Errorf("error removing IAM User (%s) MFA devices: %s", username, err)
-		}
+	if err := deleteAwsIamUserAccessKeys(conn, username); err != nil {
+		return fmt.Errorf("error removing access keys: %s", err)
+	}
 
-		if err := deleteAwsIamUserLoginProfile(conn, username); err != nil {
-			return fmt.Errorf("error removing IAM User (%s) login profile: %s", username, err)
-		}
+	if err := deleteAwsIamUserSSHKeys(conn, username); err != nil {
+		return fmt.Errorf("error removing SSH keys: %s", err)
+	}
 
-		input := &iam.DeleteUserInput{
-			UserName: aws.String(username),
-		}
+	if err := deleteAwsIamUserMFADevices(conn, username); err != nil {
+		return fmt.Errorf("error removing MFA devices: %s", err)
+	}
 
-		_, err = conn.DeleteUser(input)
+	if err := deleteAwsIamUserLoginProfile(conn, username); err != nil {
+		return fmt.Errorf("error removing login profile: %s", err)
+	}
 
-		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
+	_, err = conn.DeleteUser(&iam.DeleteUserInput{
+		UserName: aws.String(username),
+	})
 
-		if err != nil {
-			return fmt.Errorf("Error deleting IAM User (%s): %s", username, err)
-		}
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
 	}
 
-	return nil
+	return err
 }
 
 func TestAccAWSUser_importBasic(t *testing.T) {
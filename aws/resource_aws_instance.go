@@ -30,6 +30,7 @@ func resourceAwsInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsInstanceMigrateState,
@@ -306,7 +307,8 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"volume_tags": tagsSchemaComputed(),
 
@@ -551,7 +553,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	tagsSpec := make([]*ec2.TagSpecification, 0)
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := tagsFromMap(v.(map[string]interface{}))
 
 		spec := &ec2.TagSpecification{
@@ -802,9 +804,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(instance.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
-	if err := readVolumeTags(conn, d); err != nil {
+	if err := readVolumeTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -898,11 +900,12 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if d.HasChange("tags") && !d.IsNewResource() {
+	if d.HasChange("tags_all") && !d.IsNewResource() {
 		if err := setTags(conn, d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 	if d.HasChange("volume_tags") && !d.IsNewResource() {
 		if err := setVolumeTags(conn, d); err != nil {
@@ -1595,7 +1598,7 @@ func readBlockDeviceMappingsFromConfig(
 	return blockDevices, nil
 }
 
-func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData, ignoreConfig *IgnoreTagsConfig) error {
 	volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 	if err != nil {
 		return err
@@ -1623,7 +1626,7 @@ func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		tags = append(tags, tag)
 	}
 
-	d.Set("volume_tags", tagsToMap(tags))
+	d.Set("volume_tags", tagsToMap(tags, ignoreConfig))
 
 	return nil
 }
@@ -21,13 +21,15 @@ func resourceAwsInternetGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -103,7 +105,7 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("vpc_id", ig.Attachments[0].VpcId)
 	}
 
-	d.Set("tags", tagsToMap(ig.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(ig.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	d.Set("owner_id", ig.OwnerId)
 
 	return nil
@@ -130,6 +132,8 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetPartial("tags")
 
+	d.SetPartial("tags_all")
+
 	return resourceAwsInternetGatewayRead(d, meta)
 }
 
@@ -11,39 +11,22 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
-		Name: "aws_internet_gateway",
+	addTestSweeper(&testSweeper{
+		Name:        "aws_internet_gateway",
+		Description: "EC2 Internet Gateway",
 		Dependencies: []string{
 			"aws_subnet",
 		},
-		F: testSweepInternetGateways,
+		List: testSweepInternetGateways,
 	})
 }
 
-func testSweepInternetGateways(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).ec2conn
-
-	req := &ec2.DescribeInternetGatewaysInput{}
-	resp, err := conn.DescribeInternetGateways(req)
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping Internet Gateway sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing Internet Gateways: %s", err)
-	}
-
-	if len(resp.InternetGateways) == 0 {
-		log.Print("[DEBUG] No AWS Internet Gateways to sweep")
-		return nil
-	}
+func testSweepInternetGateways(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.ec2conn
 
 	defaultVPCID := ""
 	describeVpcsInput := &ec2.DescribeVpcsInput{
@@ -58,64 +41,82 @@ func testSweepInternetGateways(region string) error {
 	describeVpcsOutput, err := conn.DescribeVpcs(describeVpcsInput)
 
 	if err != nil {
-		return fmt.Errorf("Error describing VPCs: %s", err)
+		return nil, fmt.Errorf("error describing VPCs: %s", err)
 	}
 
 	if describeVpcsOutput != nil && len(describeVpcsOutput.Vpcs) == 1 {
 		defaultVPCID = aws.StringValue(describeVpcsOutput.Vpcs[0].VpcId)
 	}
 
-	for _, internetGateway := range resp.InternetGateways {
-		isDefaultVPCInternetGateway := false
-
-		for _, attachment := range internetGateway.Attachments {
-			if aws.StringValue(attachment.VpcId) == defaultVPCID {
-				isDefaultVPCInternetGateway = true
-				break
-			}
+	var resources []*testSweepResource
 
-			input := &ec2.DetachInternetGatewayInput{
-				InternetGatewayId: internetGateway.InternetGatewayId,
-				VpcId:             attachment.VpcId,
+	err = conn.DescribeInternetGatewaysPages(&ec2.DescribeInternetGatewaysInput{}, func(page *ec2.DescribeInternetGatewaysOutput, lastPage bool) bool {
+		for _, internetGateway := range page.InternetGateways {
+			if internetGateway == nil {
+				continue
 			}
 
-			log.Printf("[DEBUG] Detaching Internet Gateway: %s", input)
-			_, err := conn.DetachInternetGateway(input)
-			if err != nil {
-				return fmt.Errorf("error detaching Internet Gateway (%s) from VPC (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId), err)
-			}
+			internetGatewayID := aws.StringValue(internetGateway.InternetGatewayId)
+			isDefaultVPCInternetGateway := false
 
-			stateConf := &resource.StateChangeConf{
-				Pending: []string{"detaching"},
-				Target:  []string{"detached"},
-				Refresh: detachIGStateRefreshFunc(conn, aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId)),
-				Timeout: 10 * time.Minute,
-				Delay:   10 * time.Second,
+			for _, attachment := range internetGateway.Attachments {
+				if aws.StringValue(attachment.VpcId) == defaultVPCID {
+					isDefaultVPCInternetGateway = true
+					break
+				}
 			}
 
-			log.Printf("[DEBUG] Waiting for Internet Gateway (%s) to detach from VPC (%s)", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId))
-			if _, err = stateConf.WaitForState(); err != nil {
-				return fmt.Errorf("error waiting for VPN Gateway (%s) to detach from VPC (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId), err)
+			if isDefaultVPCInternetGateway {
+				log.Printf("[DEBUG] Skipping Default VPC Internet Gateway: %s", internetGatewayID)
+				continue
 			}
-		}
-
-		if isDefaultVPCInternetGateway {
-			log.Printf("[DEBUG] Skipping Default VPC Internet Gateway: %s", aws.StringValue(internetGateway.InternetGatewayId))
-			continue
-		}
 
-		input := &ec2.DeleteInternetGatewayInput{
-			InternetGatewayId: internetGateway.InternetGatewayId,
+			attachments := internetGateway.Attachments
+			tags := keyvaluetags.Ec2KeyValueTags(internetGateway.Tags).Map()
+
+			resources = append(resources, &testSweepResource{
+				ID:   internetGatewayID,
+				Name: tags["Name"],
+				Tags: tags,
+				Delete: func() error {
+					for _, attachment := range attachments {
+						vpcID := aws.StringValue(attachment.VpcId)
+						input := &ec2.DetachInternetGatewayInput{
+							InternetGatewayId: aws.String(internetGatewayID),
+							VpcId:             attachment.VpcId,
+						}
+
+						log.Printf("[DEBUG] Detaching Internet Gateway: %s", input)
+						if _, err := conn.DetachInternetGateway(input); err != nil {
+							return fmt.Errorf("error detaching from VPC (%s): %s", vpcID, err)
+						}
+
+						stateConf := &resource.StateChangeConf{
+							Pending: []string{"detaching"},
+							Target:  []string{"detached"},
+							Refresh: detachIGStateRefreshFunc(conn, internetGatewayID, vpcID),
+							Timeout: 10 * time.Minute,
+							Delay:   10 * time.Second,
+						}
+
+						log.Printf("[DEBUG] Waiting for Internet Gateway (%s) to detach from VPC (%s)", internetGatewayID, vpcID)
+						if _, err := stateConf.WaitForState(); err != nil {
+							return fmt.Errorf("error waiting for detachment from VPC (%s): %s", vpcID, err)
+						}
+					}
+
+					_, err := conn.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+						InternetGatewayId: aws.String(internetGatewayID),
+					})
+					return err
+				},
+			})
 		}
 
-		log.Printf("[DEBUG] Deleting Internet Gateway: %s", input)
-		_, err := conn.DeleteInternetGateway(input)
-		if err != nil {
-			return fmt.Errorf("error deleting Internet Gateway (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), err)
-		}
-	}
+		return !lastPage
+	})
 
-	return nil
+	return resources, err
 }
 
 func TestAccAWSInternetGateway_importBasic(t *testing.T) {
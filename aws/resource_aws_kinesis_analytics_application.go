@@ -29,6 +29,7 @@ func resourceAwsKinesisAnalyticsApplication() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -552,7 +553,8 @@ func resourceAwsKinesisAnalyticsApplication() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -589,7 +591,7 @@ func resourceAwsKinesisAnalyticsApplicationCreate(d *schema.ResourceData, meta i
 		createOpts.Outputs = outputs
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		createOpts.Tags = tagsFromMapKinesisAnalytics(v.(map[string]interface{}))
 	}
 
@@ -670,7 +672,7 @@ func resourceAwsKinesisAnalyticsApplicationRead(d *schema.ResourceData, meta int
 		return fmt.Errorf("error setting reference_data_sources: %s", err)
 	}
 
-	if err := getTagsKinesisAnalytics(conn, d); err != nil {
+	if err := getTagsKinesisAnalytics(conn, d, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
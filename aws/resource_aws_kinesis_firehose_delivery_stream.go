@@ -646,6 +646,7 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: setTagsDiff,
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsKinesisFirehoseMigrateState,
@@ -664,7 +665,8 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"kinesis_source_configuration": {
 				Type:     schema.TypeList,
@@ -2078,7 +2080,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		createInput.Tags = tagsFromMapKinesisFirehose(v.(map[string]interface{}))
 	}
 
@@ -2281,7 +2283,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta i
 		return err
 	}
 
-	if err := getTagsKinesisFirehose(conn, d, sn); err != nil {
+	if err := getTagsKinesisFirehose(conn, d, sn, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return err
 	}
 
@@ -24,6 +24,7 @@ func resourceAwsKinesisStream() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsKinesisStreamImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -95,7 +96,8 @@ func resourceAwsKinesisStream() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -153,6 +155,8 @@ func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.SetPartial("tags")
+
+	d.SetPartial("tags_all")
 	d.Partial(false)
 
 	if err := updateKinesisShardCount(conn, d); err != nil {
@@ -208,7 +212,7 @@ func resourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving tags for Stream: %s. %s", sn, err)
 	} else {
-		d.Set("tags", tagsToMapKinesis(tagsResp.Tags))
+		setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapKinesis(tagsResp.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	return nil
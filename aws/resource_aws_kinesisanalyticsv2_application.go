@@ -0,0 +1,1215 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsV2ApplicationCreate,
+		Read:   resourceAwsKinesisAnalyticsV2ApplicationRead,
+		Update: resourceAwsKinesisAnalyticsV2ApplicationUpdate,
+		Delete: resourceAwsKinesisAnalyticsV2ApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsKinesisAnalyticsV2ApplicationImport,
+		},
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_code_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_content": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_content_location": {
+													Type:          schema.TypeList,
+													Optional:      true,
+													MaxItems:      1,
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.text_content"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"file_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+															"object_version": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"text_content": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ValidateFunc:  validation.StringLenBetween(0, 102400),
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location"},
+												},
+											},
+										},
+									},
+									"code_content_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											kinesisanalyticsv2.CodeContentTypePlaintext,
+											kinesisanalyticsv2.CodeContentTypeZipfile,
+										}, false),
+									},
+								},
+							},
+						},
+						"application_snapshot_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshots_enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"environment_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"property_group": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"property_group_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+												"property_map": {
+													Type:     schema.TypeMap,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"flink_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"checkpoint_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"checkpoint_interval": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"checkpointing_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"min_pause_between_checkpoints": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"log_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.LogLevelDebug,
+														kinesisanalyticsv2.LogLevelError,
+														kinesisanalyticsv2.LogLevelInfo,
+														kinesisanalyticsv2.LogLevelWarn,
+													}, false),
+												},
+												"metrics_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.MetricsLevelApplication,
+														kinesisanalyticsv2.MetricsLevelOperator,
+														kinesisanalyticsv2.MetricsLevelParallelism,
+														kinesisanalyticsv2.MetricsLevelTask,
+													}, false),
+												},
+											},
+										},
+									},
+									"parallelism_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"auto_scaling_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"parallelism": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"parallelism_per_kpu": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"run_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_restore_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"application_restore_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ApplicationRestoreTypeRestoreFromCustomSnapshot,
+														kinesisanalyticsv2.ApplicationRestoreTypeRestoreFromLatestSnapshot,
+														kinesisanalyticsv2.ApplicationRestoreTypeSkipRestoreFromSnapshot,
+													}, false),
+												},
+												"snapshot_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_logging_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logging_option_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"last_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"runtime_environment": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					kinesisanalyticsv2.RuntimeEnvironmentFlink16,
+				}, false),
+			},
+			"service_execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_application": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+
+	input := &kinesisanalyticsv2.CreateApplicationInput{
+		ApplicationConfiguration: expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{})),
+		ApplicationDescription:   aws.String(d.Get("description").(string)),
+		ApplicationName:          aws.String(name),
+		CloudWatchLoggingOptions: expandKinesisAnalyticsV2CloudWatchLoggingOptions(d.Get("cloudwatch_logging_options").([]interface{})),
+		RuntimeEnvironment:       aws.String(d.Get("runtime_environment").(string)),
+		ServiceExecutionRole:     aws.String(d.Get("service_execution_role").(string)),
+	}
+
+	if v := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws(); len(v) > 0 {
+		input.Tags = v.Kinesisanalyticsv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application: %s", input)
+
+	var output *kinesisanalyticsv2.CreateApplicationOutput
+	// Retry for IAM eventual consistency
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+
+		output, err = conn.CreateApplication(input)
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Please check the role provided or validity of S3 location you provided") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = conn.CreateApplication(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationDetail.ApplicationARN))
+
+	if d.Get("start_application").(bool) {
+		if err := kinesisAnalyticsV2StartApplication(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	application, err := describeKinesisAnalyticsV2Application(conn, d.Get("name").(string))
+
+	if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Kinesis Analytics v2 Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(application.ApplicationARN)
+	status := aws.StringValue(application.ApplicationStatus)
+
+	if err := d.Set("application_configuration", flattenKinesisAnalyticsV2ApplicationConfiguration(application.ApplicationConfigurationDescription)); err != nil {
+		return fmt.Errorf("error setting application_configuration: %s", err)
+	}
+
+	d.Set("arn", arn)
+
+	if err := d.Set("cloudwatch_logging_options", flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(application.CloudWatchLoggingOptionDescriptions)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_logging_options: %s", err)
+	}
+
+	d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
+	d.Set("description", application.ApplicationDescription)
+	d.Set("last_update_timestamp", aws.TimeValue(application.LastUpdateTimestamp).Format(time.RFC3339))
+	d.Set("name", application.ApplicationName)
+	d.Set("runtime_environment", application.RuntimeEnvironment)
+	d.Set("service_execution_role", application.ServiceExecutionRole)
+	d.Set("start_application", status == kinesisanalyticsv2.ApplicationStatusRunning || status == kinesisanalyticsv2.ApplicationStatusStarting)
+	d.Set("status", status)
+	d.Set("version_id", application.ApplicationVersionId)
+
+	tagsOutput, err := conn.ListTagsForResource(&kinesisanalyticsv2.ListTagsForResourceInput{
+		ResourceARN: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Analytics v2 Application (%s): %s", arn, err)
+	}
+
+	tags := keyvaluetags.Kinesisanalyticsv2KeyValueTags(tagsOutput.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig)
+
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags.Map()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+	versionID := int64(d.Get("version_id").(int))
+	updateApplication := false
+
+	input := &kinesisanalyticsv2.UpdateApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	if d.HasChange("application_configuration") {
+		applicationConfigurationUpdate := expandKinesisAnalyticsV2ApplicationConfigurationUpdate(d.Get("application_configuration").([]interface{}))
+
+		if applicationConfigurationUpdate != nil {
+			input.ApplicationConfigurationUpdate = applicationConfigurationUpdate
+			updateApplication = true
+		}
+
+		// Run configuration is only accepted while the application is running.
+		if d.Get("status").(string) == kinesisanalyticsv2.ApplicationStatusRunning && d.HasChange("application_configuration.0.run_configuration") {
+			input.RunConfigurationUpdate = expandKinesisAnalyticsV2RunConfigurationUpdate(d.Get("application_configuration.0.run_configuration").([]interface{}))
+			updateApplication = true
+		}
+	}
+
+	if d.HasChange("cloudwatch_logging_options") {
+		o, n := d.GetChange("cloudwatch_logging_options")
+
+		switch {
+		case len(o.([]interface{})) == 0 && len(n.([]interface{})) > 0:
+			addInput := &kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(name),
+				CloudWatchLoggingOption:     expandKinesisAnalyticsV2CloudWatchLoggingOptions(n.([]interface{}))[0],
+				CurrentApplicationVersionId: aws.Int64(versionID),
+			}
+
+			log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), addInput)
+			output, err := conn.AddApplicationCloudWatchLoggingOption(addInput)
+
+			if err != nil {
+				return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), err)
+			}
+
+			// The next request must use the version created by this one
+			versionID = aws.Int64Value(output.ApplicationVersionId)
+
+			if err := waitForKinesisAnalyticsV2ApplicationUpdate(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) CloudWatch logging option add: %s", d.Id(), err)
+			}
+		case len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0:
+			deleteInput := &kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(name),
+				CloudWatchLoggingOptionId:   aws.String(o.([]interface{})[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+				CurrentApplicationVersionId: aws.Int64(versionID),
+			}
+
+			log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), deleteInput)
+			output, err := conn.DeleteApplicationCloudWatchLoggingOption(deleteInput)
+
+			if err != nil {
+				return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), err)
+			}
+
+			// The next request must use the version created by this one
+			versionID = aws.Int64Value(output.ApplicationVersionId)
+
+			if err := waitForKinesisAnalyticsV2ApplicationUpdate(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) CloudWatch logging option deletion: %s", d.Id(), err)
+			}
+		default:
+			input.CloudWatchLoggingOptionUpdates = []*kinesisanalyticsv2.CloudWatchLoggingOptionUpdate{
+				{
+					CloudWatchLoggingOptionId: aws.String(o.([]interface{})[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+					LogStreamARNUpdate:        aws.String(n.([]interface{})[0].(map[string]interface{})["log_stream_arn"].(string)),
+				},
+			}
+			updateApplication = true
+		}
+	}
+
+	if d.HasChange("service_execution_role") {
+		input.ServiceExecutionRoleUpdate = aws.String(d.Get("service_execution_role").(string))
+		updateApplication = true
+	}
+
+	if updateApplication {
+		input.CurrentApplicationVersionId = aws.Int64(versionID)
+
+		log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application (%s): %s", d.Id(), input)
+		// Retry for IAM eventual consistency
+		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+			_, err := conn.UpdateApplication(input)
+
+			if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+
+		if isResourceTimeoutError(err) {
+			_, err = conn.UpdateApplication(input)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForKinesisAnalyticsV2ApplicationUpdate(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_application") {
+		if d.Get("start_application").(bool) {
+			if err := kinesisAnalyticsV2StartApplication(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			if err := kinesisAnalyticsV2StopApplication(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Kinesisanalyticsv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s) tags: %s", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+
+	createTimestamp, err := time.Parse(time.RFC3339, d.Get("create_timestamp").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing Kinesis Analytics v2 Application (%s) create_timestamp: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application: %s", d.Id())
+	_, err = conn.DeleteApplication(&kinesisanalyticsv2.DeleteApplicationInput{
+		ApplicationName: aws.String(name),
+		CreateTimestamp: aws.Time(createTimestamp),
+	})
+
+	if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForKinesisAnalyticsV2ApplicationDeletion(conn, name, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	applicationARN, err := arn.Parse(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Kinesis Analytics v2 Application ARN (%s): %s", d.Id(), err)
+	}
+
+	// application/<name>
+	parts := regexp.MustCompile(`^application/(.+)$`).FindStringSubmatch(applicationARN.Resource)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format for Kinesis Analytics v2 Application ARN (%s), expected resource application/<name>", d.Id())
+	}
+
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func describeKinesisAnalyticsV2Application(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string) (*kinesisanalyticsv2.ApplicationDetail, error) {
+	output, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+		ApplicationName: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ApplicationDetail == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return output.ApplicationDetail, nil
+}
+
+func kinesisAnalyticsV2StartApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, d *schema.ResourceData, timeout time.Duration) error {
+	name := d.Get("name").(string)
+
+	input := &kinesisanalyticsv2.StartApplicationInput{
+		ApplicationName:  aws.String(name),
+		RunConfiguration: &kinesisanalyticsv2.RunConfiguration{},
+	}
+
+	if v, ok := d.GetOk("application_configuration.0.run_configuration.0.application_restore_configuration"); ok {
+		input.RunConfiguration.ApplicationRestoreConfiguration = expandKinesisAnalyticsV2ApplicationRestoreConfiguration(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Starting Kinesis Analytics v2 Application: %s", input)
+	if _, err := conn.StartApplication(input); err != nil {
+		return fmt.Errorf("error starting Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusStarting},
+		Target:  []string{kinesisanalyticsv2.ApplicationStatusRunning},
+		Refresh: refreshKinesisAnalyticsV2ApplicationStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to start: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func kinesisAnalyticsV2StopApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, d *schema.ResourceData, timeout time.Duration) error {
+	name := d.Get("name").(string)
+
+	input := &kinesisanalyticsv2.StopApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Stopping Kinesis Analytics v2 Application: %s", input)
+	if _, err := conn.StopApplication(input); err != nil {
+		return fmt.Errorf("error stopping Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusStopping},
+		Target:  []string{kinesisanalyticsv2.ApplicationStatusReady},
+		Refresh: refreshKinesisAnalyticsV2ApplicationStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to stop: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForKinesisAnalyticsV2ApplicationUpdate(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusUpdating},
+		Target: []string{
+			kinesisanalyticsv2.ApplicationStatusReady,
+			kinesisanalyticsv2.ApplicationStatusRunning,
+		},
+		Refresh: refreshKinesisAnalyticsV2ApplicationStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForKinesisAnalyticsV2ApplicationDeletion(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			kinesisanalyticsv2.ApplicationStatusDeleting,
+			kinesisanalyticsv2.ApplicationStatusReady,
+			kinesisanalyticsv2.ApplicationStatusRunning,
+			kinesisanalyticsv2.ApplicationStatusStopping,
+		},
+		Target:  []string{},
+		Refresh: refreshKinesisAnalyticsV2ApplicationStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func refreshKinesisAnalyticsV2ApplicationStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		application, err := describeKinesisAnalyticsV2Application(conn, name)
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return application, aws.StringValue(application.ApplicationStatus), nil
+	}
+}
+
+func expandKinesisAnalyticsV2ApplicationConfiguration(l []interface{}) *kinesisanalyticsv2.ApplicationConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	applicationConfiguration := &kinesisanalyticsv2.ApplicationConfiguration{
+		ApplicationCodeConfiguration: expandKinesisAnalyticsV2ApplicationCodeConfiguration(m["application_code_configuration"].([]interface{})),
+	}
+
+	if v, ok := m["application_snapshot_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationConfiguration.ApplicationSnapshotConfiguration = &kinesisanalyticsv2.ApplicationSnapshotConfiguration{
+			SnapshotsEnabled: aws.Bool(v[0].(map[string]interface{})["snapshots_enabled"].(bool)),
+		}
+	}
+
+	if v, ok := m["environment_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationConfiguration.EnvironmentProperties = &kinesisanalyticsv2.EnvironmentProperties{
+			PropertyGroups: expandKinesisAnalyticsV2PropertyGroups(v[0].(map[string]interface{})["property_group"].(*schema.Set).List()),
+		}
+	}
+
+	if v, ok := m["flink_application_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mFlink := v[0].(map[string]interface{})
+		applicationConfiguration.FlinkApplicationConfiguration = &kinesisanalyticsv2.FlinkApplicationConfiguration{}
+
+		if v, ok := mFlink["checkpoint_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mCheckpoint := v[0].(map[string]interface{})
+			configurationType := mCheckpoint["configuration_type"].(string)
+
+			checkpointConfiguration := &kinesisanalyticsv2.CheckpointConfiguration{
+				ConfigurationType: aws.String(configurationType),
+			}
+
+			if configurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+				if v, ok := mCheckpoint["checkpoint_interval"].(int); ok && v > 0 {
+					checkpointConfiguration.CheckpointInterval = aws.Int64(int64(v))
+				}
+				if v, ok := mCheckpoint["checkpointing_enabled"].(bool); ok {
+					checkpointConfiguration.CheckpointingEnabled = aws.Bool(v)
+				}
+				if v, ok := mCheckpoint["min_pause_between_checkpoints"].(int); ok && v > 0 {
+					checkpointConfiguration.MinPauseBetweenCheckpoints = aws.Int64(int64(v))
+				}
+			}
+
+			applicationConfiguration.FlinkApplicationConfiguration.CheckpointConfiguration = checkpointConfiguration
+		}
+
+		if v, ok := mFlink["monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mMonitoring := v[0].(map[string]interface{})
+			configurationType := mMonitoring["configuration_type"].(string)
+
+			monitoringConfiguration := &kinesisanalyticsv2.MonitoringConfiguration{
+				ConfigurationType: aws.String(configurationType),
+			}
+
+			if configurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+				if v, ok := mMonitoring["log_level"].(string); ok && v != "" {
+					monitoringConfiguration.LogLevel = aws.String(v)
+				}
+				if v, ok := mMonitoring["metrics_level"].(string); ok && v != "" {
+					monitoringConfiguration.MetricsLevel = aws.String(v)
+				}
+			}
+
+			applicationConfiguration.FlinkApplicationConfiguration.MonitoringConfiguration = monitoringConfiguration
+		}
+
+		if v, ok := mFlink["parallelism_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mParallelism := v[0].(map[string]interface{})
+			configurationType := mParallelism["configuration_type"].(string)
+
+			parallelismConfiguration := &kinesisanalyticsv2.ParallelismConfiguration{
+				ConfigurationType: aws.String(configurationType),
+			}
+
+			if configurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+				if v, ok := mParallelism["auto_scaling_enabled"].(bool); ok {
+					parallelismConfiguration.AutoScalingEnabled = aws.Bool(v)
+				}
+				if v, ok := mParallelism["parallelism"].(int); ok && v > 0 {
+					parallelismConfiguration.Parallelism = aws.Int64(int64(v))
+				}
+				if v, ok := mParallelism["parallelism_per_kpu"].(int); ok && v > 0 {
+					parallelismConfiguration.ParallelismPerKPU = aws.Int64(int64(v))
+				}
+			}
+
+			applicationConfiguration.FlinkApplicationConfiguration.ParallelismConfiguration = parallelismConfiguration
+		}
+	}
+
+	return applicationConfiguration
+}
+
+func expandKinesisAnalyticsV2ApplicationCodeConfiguration(l []interface{}) *kinesisanalyticsv2.ApplicationCodeConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	applicationCodeConfiguration := &kinesisanalyticsv2.ApplicationCodeConfiguration{
+		CodeContentType: aws.String(m["code_content_type"].(string)),
+	}
+
+	if v, ok := m["code_content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mCodeContent := v[0].(map[string]interface{})
+		applicationCodeConfiguration.CodeContent = &kinesisanalyticsv2.CodeContent{}
+
+		if v, ok := mCodeContent["s3_content_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mS3ContentLocation := v[0].(map[string]interface{})
+
+			applicationCodeConfiguration.CodeContent.S3ContentLocation = &kinesisanalyticsv2.S3ContentLocation{
+				BucketARN: aws.String(mS3ContentLocation["bucket_arn"].(string)),
+				FileKey:   aws.String(mS3ContentLocation["file_key"].(string)),
+			}
+
+			if v, ok := mS3ContentLocation["object_version"].(string); ok && v != "" {
+				applicationCodeConfiguration.CodeContent.S3ContentLocation.ObjectVersion = aws.String(v)
+			}
+		}
+
+		if v, ok := mCodeContent["text_content"].(string); ok && v != "" {
+			applicationCodeConfiguration.CodeContent.TextContent = aws.String(v)
+		}
+	}
+
+	return applicationCodeConfiguration
+}
+
+func expandKinesisAnalyticsV2ApplicationConfigurationUpdate(l []interface{}) *kinesisanalyticsv2.ApplicationConfigurationUpdate {
+	applicationConfiguration := expandKinesisAnalyticsV2ApplicationConfiguration(l)
+
+	if applicationConfiguration == nil {
+		return nil
+	}
+
+	applicationConfigurationUpdate := &kinesisanalyticsv2.ApplicationConfigurationUpdate{}
+
+	if applicationCodeConfiguration := applicationConfiguration.ApplicationCodeConfiguration; applicationCodeConfiguration != nil {
+		applicationConfigurationUpdate.ApplicationCodeConfigurationUpdate = &kinesisanalyticsv2.ApplicationCodeConfigurationUpdate{
+			CodeContentTypeUpdate: applicationCodeConfiguration.CodeContentType,
+		}
+
+		if codeContent := applicationCodeConfiguration.CodeContent; codeContent != nil {
+			applicationConfigurationUpdate.ApplicationCodeConfigurationUpdate.CodeContentUpdate = &kinesisanalyticsv2.CodeContentUpdate{
+				TextContentUpdate: codeContent.TextContent,
+			}
+
+			if s3ContentLocation := codeContent.S3ContentLocation; s3ContentLocation != nil {
+				applicationConfigurationUpdate.ApplicationCodeConfigurationUpdate.CodeContentUpdate.S3ContentLocationUpdate = &kinesisanalyticsv2.S3ContentLocationUpdate{
+					BucketARNUpdate:     s3ContentLocation.BucketARN,
+					FileKeyUpdate:       s3ContentLocation.FileKey,
+					ObjectVersionUpdate: s3ContentLocation.ObjectVersion,
+				}
+			}
+		}
+	}
+
+	if applicationSnapshotConfiguration := applicationConfiguration.ApplicationSnapshotConfiguration; applicationSnapshotConfiguration != nil {
+		applicationConfigurationUpdate.ApplicationSnapshotConfigurationUpdate = &kinesisanalyticsv2.ApplicationSnapshotConfigurationUpdate{
+			SnapshotsEnabledUpdate: applicationSnapshotConfiguration.SnapshotsEnabled,
+		}
+	}
+
+	// An empty list of property groups removes all environment properties.
+	applicationConfigurationUpdate.EnvironmentPropertyUpdates = &kinesisanalyticsv2.EnvironmentPropertyUpdates{
+		PropertyGroups: []*kinesisanalyticsv2.PropertyGroup{},
+	}
+
+	if environmentProperties := applicationConfiguration.EnvironmentProperties; environmentProperties != nil {
+		applicationConfigurationUpdate.EnvironmentPropertyUpdates.PropertyGroups = environmentProperties.PropertyGroups
+	}
+
+	if flinkApplicationConfiguration := applicationConfiguration.FlinkApplicationConfiguration; flinkApplicationConfiguration != nil {
+		applicationConfigurationUpdate.FlinkApplicationConfigurationUpdate = &kinesisanalyticsv2.FlinkApplicationConfigurationUpdate{}
+
+		if checkpointConfiguration := flinkApplicationConfiguration.CheckpointConfiguration; checkpointConfiguration != nil {
+			applicationConfigurationUpdate.FlinkApplicationConfigurationUpdate.CheckpointConfigurationUpdate = &kinesisanalyticsv2.CheckpointConfigurationUpdate{
+				CheckpointIntervalUpdate:         checkpointConfiguration.CheckpointInterval,
+				CheckpointingEnabledUpdate:       checkpointConfiguration.CheckpointingEnabled,
+				ConfigurationTypeUpdate:          checkpointConfiguration.ConfigurationType,
+				MinPauseBetweenCheckpointsUpdate: checkpointConfiguration.MinPauseBetweenCheckpoints,
+			}
+		}
+
+		if monitoringConfiguration := flinkApplicationConfiguration.MonitoringConfiguration; monitoringConfiguration != nil {
+			applicationConfigurationUpdate.FlinkApplicationConfigurationUpdate.MonitoringConfigurationUpdate = &kinesisanalyticsv2.MonitoringConfigurationUpdate{
+				ConfigurationTypeUpdate: monitoringConfiguration.ConfigurationType,
+				LogLevelUpdate:          monitoringConfiguration.LogLevel,
+				MetricsLevelUpdate:      monitoringConfiguration.MetricsLevel,
+			}
+		}
+
+		if parallelismConfiguration := flinkApplicationConfiguration.ParallelismConfiguration; parallelismConfiguration != nil {
+			applicationConfigurationUpdate.FlinkApplicationConfigurationUpdate.ParallelismConfigurationUpdate = &kinesisanalyticsv2.ParallelismConfigurationUpdate{
+				AutoScalingEnabledUpdate: parallelismConfiguration.AutoScalingEnabled,
+				ConfigurationTypeUpdate:  parallelismConfiguration.ConfigurationType,
+				ParallelismPerKPUUpdate:  parallelismConfiguration.ParallelismPerKPU,
+				ParallelismUpdate:        parallelismConfiguration.Parallelism,
+			}
+		}
+	}
+
+	return applicationConfigurationUpdate
+}
+
+func expandKinesisAnalyticsV2ApplicationRestoreConfiguration(l []interface{}) *kinesisanalyticsv2.ApplicationRestoreConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	applicationRestoreConfiguration := &kinesisanalyticsv2.ApplicationRestoreConfiguration{}
+
+	if v, ok := m["application_restore_type"].(string); ok && v != "" {
+		applicationRestoreConfiguration.ApplicationRestoreType = aws.String(v)
+	}
+
+	if v, ok := m["snapshot_name"].(string); ok && v != "" {
+		applicationRestoreConfiguration.SnapshotName = aws.String(v)
+	}
+
+	if applicationRestoreConfiguration.ApplicationRestoreType == nil {
+		return nil
+	}
+
+	return applicationRestoreConfiguration
+}
+
+func expandKinesisAnalyticsV2RunConfigurationUpdate(l []interface{}) *kinesisanalyticsv2.RunConfigurationUpdate {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &kinesisanalyticsv2.RunConfigurationUpdate{
+		ApplicationRestoreConfiguration: expandKinesisAnalyticsV2ApplicationRestoreConfiguration(m["application_restore_configuration"].([]interface{})),
+	}
+}
+
+func expandKinesisAnalyticsV2PropertyGroups(l []interface{}) []*kinesisanalyticsv2.PropertyGroup {
+	propertyGroups := make([]*kinesisanalyticsv2.PropertyGroup, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		propertyGroups = append(propertyGroups, &kinesisanalyticsv2.PropertyGroup{
+			PropertyGroupId: aws.String(m["property_group_id"].(string)),
+			PropertyMap:     stringMapToPointers(m["property_map"].(map[string]interface{})),
+		})
+	}
+
+	return propertyGroups
+}
+
+func expandKinesisAnalyticsV2CloudWatchLoggingOptions(l []interface{}) []*kinesisanalyticsv2.CloudWatchLoggingOption {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return []*kinesisanalyticsv2.CloudWatchLoggingOption{
+		{
+			LogStreamARN: aws.String(m["log_stream_arn"].(string)),
+		},
+	}
+}
+
+func flattenKinesisAnalyticsV2ApplicationConfiguration(applicationConfiguration *kinesisanalyticsv2.ApplicationConfigurationDescription) []interface{} {
+	if applicationConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if applicationCodeConfiguration := applicationConfiguration.ApplicationCodeConfigurationDescription; applicationCodeConfiguration != nil {
+		mApplicationCodeConfiguration := map[string]interface{}{
+			"code_content_type": aws.StringValue(applicationCodeConfiguration.CodeContentType),
+		}
+
+		if codeContent := applicationCodeConfiguration.CodeContentDescription; codeContent != nil {
+			mCodeContent := map[string]interface{}{
+				"text_content": aws.StringValue(codeContent.TextContent),
+			}
+
+			if s3ContentLocation := codeContent.S3ApplicationCodeLocationDescription; s3ContentLocation != nil {
+				mCodeContent["s3_content_location"] = []interface{}{
+					map[string]interface{}{
+						"bucket_arn":     aws.StringValue(s3ContentLocation.BucketARN),
+						"file_key":       aws.StringValue(s3ContentLocation.FileKey),
+						"object_version": aws.StringValue(s3ContentLocation.ObjectVersion),
+					},
+				}
+			}
+
+			mApplicationCodeConfiguration["code_content"] = []interface{}{mCodeContent}
+		}
+
+		m["application_code_configuration"] = []interface{}{mApplicationCodeConfiguration}
+	}
+
+	if applicationSnapshotConfiguration := applicationConfiguration.ApplicationSnapshotConfigurationDescription; applicationSnapshotConfiguration != nil {
+		m["application_snapshot_configuration"] = []interface{}{
+			map[string]interface{}{
+				"snapshots_enabled": aws.BoolValue(applicationSnapshotConfiguration.SnapshotsEnabled),
+			},
+		}
+	}
+
+	if environmentProperties := applicationConfiguration.EnvironmentPropertyDescriptions; environmentProperties != nil && len(environmentProperties.PropertyGroupDescriptions) > 0 {
+		m["environment_properties"] = []interface{}{
+			map[string]interface{}{
+				"property_group": flattenKinesisAnalyticsV2PropertyGroups(environmentProperties.PropertyGroupDescriptions),
+			},
+		}
+	}
+
+	if flinkApplicationConfiguration := applicationConfiguration.FlinkApplicationConfigurationDescription; flinkApplicationConfiguration != nil {
+		mFlinkApplicationConfiguration := map[string]interface{}{}
+
+		if checkpointConfiguration := flinkApplicationConfiguration.CheckpointConfigurationDescription; checkpointConfiguration != nil {
+			mFlinkApplicationConfiguration["checkpoint_configuration"] = []interface{}{
+				map[string]interface{}{
+					"checkpoint_interval":           int(aws.Int64Value(checkpointConfiguration.CheckpointInterval)),
+					"checkpointing_enabled":         aws.BoolValue(checkpointConfiguration.CheckpointingEnabled),
+					"configuration_type":            aws.StringValue(checkpointConfiguration.ConfigurationType),
+					"min_pause_between_checkpoints": int(aws.Int64Value(checkpointConfiguration.MinPauseBetweenCheckpoints)),
+				},
+			}
+		}
+
+		if monitoringConfiguration := flinkApplicationConfiguration.MonitoringConfigurationDescription; monitoringConfiguration != nil {
+			mFlinkApplicationConfiguration["monitoring_configuration"] = []interface{}{
+				map[string]interface{}{
+					"configuration_type": aws.StringValue(monitoringConfiguration.ConfigurationType),
+					"log_level":          aws.StringValue(monitoringConfiguration.LogLevel),
+					"metrics_level":      aws.StringValue(monitoringConfiguration.MetricsLevel),
+				},
+			}
+		}
+
+		if parallelismConfiguration := flinkApplicationConfiguration.ParallelismConfigurationDescription; parallelismConfiguration != nil {
+			mFlinkApplicationConfiguration["parallelism_configuration"] = []interface{}{
+				map[string]interface{}{
+					"auto_scaling_enabled": aws.BoolValue(parallelismConfiguration.AutoScalingEnabled),
+					"configuration_type":   aws.StringValue(parallelismConfiguration.ConfigurationType),
+					"parallelism":          int(aws.Int64Value(parallelismConfiguration.Parallelism)),
+					"parallelism_per_kpu":  int(aws.Int64Value(parallelismConfiguration.ParallelismPerKPU)),
+				},
+			}
+		}
+
+		m["flink_application_configuration"] = []interface{}{mFlinkApplicationConfiguration}
+	}
+
+	if runConfiguration := applicationConfiguration.RunConfigurationDescription; runConfiguration != nil {
+		mRunConfiguration := map[string]interface{}{}
+
+		if applicationRestoreConfiguration := runConfiguration.ApplicationRestoreConfigurationDescription; applicationRestoreConfiguration != nil {
+			mRunConfiguration["application_restore_configuration"] = []interface{}{
+				map[string]interface{}{
+					"application_restore_type": aws.StringValue(applicationRestoreConfiguration.ApplicationRestoreType),
+					"snapshot_name":            aws.StringValue(applicationRestoreConfiguration.SnapshotName),
+				},
+			}
+		}
+
+		m["run_configuration"] = []interface{}{mRunConfiguration}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenKinesisAnalyticsV2PropertyGroups(propertyGroups []*kinesisanalyticsv2.PropertyGroup) []interface{} {
+	l := make([]interface{}, 0, len(propertyGroups))
+
+	for _, propertyGroup := range propertyGroups {
+		if propertyGroup == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"property_group_id": aws.StringValue(propertyGroup.PropertyGroupId),
+			"property_map":      pointersMapToStringList(propertyGroup.PropertyMap),
+		})
+	}
+
+	return l
+}
+
+func flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(cloudWatchLoggingOptionDescriptions []*kinesisanalyticsv2.CloudWatchLoggingOptionDescription) []interface{} {
+	if len(cloudWatchLoggingOptionDescriptions) == 0 || cloudWatchLoggingOptionDescriptions[0] == nil {
+		return []interface{}{}
+	}
+
+	cloudWatchLoggingOptionDescription := cloudWatchLoggingOptionDescriptions[0]
+
+	m := map[string]interface{}{
+		"cloudwatch_logging_option_id": aws.StringValue(cloudWatchLoggingOptionDescription.CloudWatchLoggingOptionId),
+		"log_stream_arn":               aws.StringValue(cloudWatchLoggingOptionDescription.LogStreamARN),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,760 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_kinesisanalyticsv2_application", &resource.Sweeper{
+		Name: "aws_kinesisanalyticsv2_application",
+		F:    testSweepKinesisAnalyticsV2Applications,
+	})
+}
+
+func testSweepKinesisAnalyticsV2Applications(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).kinesisanalyticsv2conn
+	input := &kinesisanalyticsv2.ListApplicationsInput{}
+
+	for {
+		output, err := conn.ListApplications(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping Kinesis Analytics v2 Application sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Kinesis Analytics v2 Applications: %s", err)
+		}
+
+		for _, summary := range output.ApplicationSummaries {
+			name := aws.StringValue(summary.ApplicationName)
+
+			application, err := describeKinesisAnalyticsV2Application(conn, name)
+
+			if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[ERROR] Error describing Kinesis Analytics v2 Application (%s): %s", name, err)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting Kinesis Analytics v2 Application: %s", name)
+			_, err = conn.DeleteApplication(&kinesisanalyticsv2.DeleteApplicationInput{
+				ApplicationName: aws.String(name),
+				CreateTimestamp: application.CreateTimestamp,
+			})
+
+			if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[ERROR] Error deleting Kinesis Analytics v2 Application (%s): %s", name, err)
+				continue
+			}
+
+			if err := waitForKinesisAnalyticsV2ApplicationDeletion(conn, name, 10*time.Minute); err != nil {
+				log.Printf("[ERROR] Error waiting for Kinesis Analytics v2 Application (%s) deletion: %s", name, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_basic(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	iamRoleResourceName := "aws_iam_role.test"
+	s3BucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_code_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_code_configuration.0.code_content.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location.0.bucket_arn", s3BucketResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_code_configuration.0.code_content_type", "ZIPFILE"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.0.snapshots_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.checkpoint_configuration.0.configuration_type", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.configuration_type", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.configuration_type", "DEFAULT"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kinesisanalytics", regexp.MustCompile(fmt.Sprintf(`application/%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "runtime_environment", "FLINK-1_6"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_execution_role", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_application", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "READY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_disappears(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					testAccCheckKinesisAnalyticsV2ApplicationDisappears(&application),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_CloudWatchLoggingOptions(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	cloudWatchLogStreamResourceName := "aws_cloudwatch_log_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigCloudWatchLoggingOptions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "cloudwatch_logging_options.0.cloudwatch_logging_option_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.log_stream_arn", cloudWatchLogStreamResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_CloudWatchLoggingOptions_EnvironmentProperties(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	cloudWatchLogStreamResourceName := "aws_cloudwatch_log_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigEnvironmentProperties(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+				),
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigCloudWatchLoggingOptionsEnvironmentProperties(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.log_stream_arn", cloudWatchLogStreamResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.0.property_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "3"),
+				),
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigEnvironmentProperties(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_EnvironmentProperties(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigEnvironmentProperties(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.0.property_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigEnvironmentProperties(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.0.property_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "2"),
+				),
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_FlinkApplicationConfiguration(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigFlinkApplicationConfiguration(rName, "INFO", "TASK", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.0.snapshots_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.checkpoint_configuration.0.checkpoint_interval", "60000"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.checkpoint_configuration.0.checkpointing_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.checkpoint_configuration.0.configuration_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.checkpoint_configuration.0.min_pause_between_checkpoints", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.configuration_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.log_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.metrics_level", "TASK"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.auto_scaling_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.configuration_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.parallelism", "2"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.parallelism_per_kpu", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigFlinkApplicationConfiguration(rName, "ERROR", "OPERATOR", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.log_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.metrics_level", "OPERATOR"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.parallelism", "4"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_Tags(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationExists(n string, v *kinesisanalyticsv2.ApplicationDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Analytics v2 Application ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+
+		application, err := describeKinesisAnalyticsV2Application(conn, rs.Primary.Attributes["name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *application
+
+		return nil
+	}
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationDisappears(application *kinesisanalyticsv2.ApplicationDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+
+		_, err := conn.DeleteApplication(&kinesisanalyticsv2.DeleteApplicationInput{
+			ApplicationName: application.ApplicationName,
+			CreateTimestamp: application.CreateTimestamp,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return waitForKinesisAnalyticsV2ApplicationDeletion(conn, aws.StringValue(application.ApplicationName), 10*time.Minute)
+	}
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesisanalyticsv2_application" {
+			continue
+		}
+
+		_, err := describeKinesisAnalyticsV2Application(conn, rs.Primary.Attributes["name"])
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Analytics v2 Application (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "kinesisanalytics.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:GetObjectVersion"
+      ],
+      "Resource": ["${aws_s3_bucket.test.arn}/*"]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:DescribeLogGroups",
+        "logs:DescribeLogStreams",
+        "logs:PutLogEvents"
+      ],
+      "Resource": ["*"]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  key    = %[1]q
+  source = "test-fixtures/lambdatest.zip"
+}
+`, rName)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfig(rName string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigCloudWatchLoggingOptions(rName string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_stream" "test" {
+  name           = %[1]q
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+}
+
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+  }
+
+  cloudwatch_logging_options {
+    log_stream_arn = "${aws_cloudwatch_log_stream.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigEnvironmentProperties(rName, value string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+
+    environment_properties {
+      property_group {
+        property_group_id = "PROPERTY-GROUP-1"
+
+        property_map = {
+          Key1 = %[2]q
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, value)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigCloudWatchLoggingOptionsEnvironmentProperties(rName, value string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_stream" "test" {
+  name           = %[1]q
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+}
+
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+
+    environment_properties {
+      property_group {
+        property_group_id = "PROPERTY-GROUP-1"
+
+        property_map = {
+          Key1 = %[2]q
+        }
+      }
+    }
+  }
+
+  cloudwatch_logging_options {
+    log_stream_arn = "${aws_cloudwatch_log_stream.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, value)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigFlinkApplicationConfiguration(rName, logLevel, metricsLevel string, parallelism int) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+
+    application_snapshot_configuration {
+      snapshots_enabled = false
+    }
+
+    flink_application_configuration {
+      checkpoint_configuration {
+        checkpoint_interval           = 60000
+        checkpointing_enabled         = true
+        configuration_type            = "CUSTOM"
+        min_pause_between_checkpoints = 5000
+      }
+
+      monitoring_configuration {
+        configuration_type = "CUSTOM"
+        log_level          = %[2]q
+        metrics_level      = %[3]q
+      }
+
+      parallelism_configuration {
+        auto_scaling_enabled = true
+        configuration_type   = "CUSTOM"
+        parallelism          = %[4]d
+        parallelism_per_kpu  = 1
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, logLevel, metricsLevel, parallelism)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
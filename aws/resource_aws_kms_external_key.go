@@ -28,6 +28,7 @@ func resourceAwsKmsExternalKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -78,7 +79,8 @@ func resourceAwsKmsExternalKey() *schema.Resource {
 					validation.ValidateJsonString,
 				),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"valid_to": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -104,7 +106,7 @@ func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) e
 		input.Policy = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
 
@@ -249,7 +251,7 @@ func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("key_usage", metadata.KeyUsage)
 	d.Set("policy", policy)
 
-	if err := d.Set("tags", tagsToMapKMS(listResourceTagsOutput.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapKMS(listResourceTagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -25,6 +25,7 @@ func resourceAwsKmsKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -72,7 +73,8 @@ func resourceAwsKmsKey() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(7, 30),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -91,7 +93,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
+	if v, exists := d.GetOk("tags_all"); exists {
 		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
 
@@ -195,7 +197,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Failed to get KMS key tags (key: %s): %s", d.Get("key_id").(string), err)
 	}
 	tagList := tOut.(*kms.ListResourceTagsOutput)
-	d.Set("tags", tagsToMapKMS(tagList.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapKMS(tagList.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
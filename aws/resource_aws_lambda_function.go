@@ -14,6 +14,7 @@ import (
 
 	"errors"
 
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -251,10 +252,14 @@ func resourceAwsLambdaFunction() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateArn,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
-		CustomizeDiff: updateComputedAttributesOnPublish,
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			updateComputedAttributesOnPublish,
+		),
 	}
 }
 
@@ -384,7 +389,7 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 		params.KMSKeyArn = aws.String(v.(string))
 	}
 
-	if v, exists := d.GetOk("tags"); exists {
+	if v, exists := d.GetOk("tags_all"); exists {
 		params.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
@@ -504,7 +509,7 @@ func resourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) err
 	// Tagging operations are permitted on Lambda functions only.
 	// Tags on aliases and versions are not supported.
 	if !qualifierExistance {
-		d.Set("tags", tagsToMapGeneric(getFunctionOutput.Tags))
+		setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapGeneric(getFunctionOutput.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	// getFunctionOutput.Code.Location is a pre-signed URL pointing at the zip
@@ -657,6 +662,7 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 		return tagErr
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	configReq := &lambda.UpdateFunctionConfigurationInput{
 		FunctionName: aws.String(d.Id()),
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -23,7 +24,10 @@ func resourceAwsLb() *schema.Resource {
 		Update: resourceAwsLbUpdate,
 		Delete: resourceAwsLbDelete,
 		// Subnets are ForceNew for Network Load Balancers
-		CustomizeDiff: customizeDiffNLBSubnets,
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			customizeDiffNLBSubnets,
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -200,7 +204,8 @@ func resourceAwsLb() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -227,7 +232,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 	elbOpts := &elbv2.CreateLoadBalancerInput{
 		Name: aws.String(name),
 		Type: aws.String(d.Get("load_balancer_type").(string)),
-		Tags: tagsFromMapELBv2(d.Get("tags").(map[string]interface{})),
+		Tags: tagsFromMapELBv2(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if scheme, ok := d.GetOk("internal"); ok && scheme.(bool) {
@@ -332,7 +337,22 @@ func resourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to find ALB: %#v", describeResp.LoadBalancers)
 	}
 
-	return flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0])
+	lb := describeResp.LoadBalancers[0]
+
+	if err := flattenAwsLbResource(d, meta, lb); err != nil {
+		return err
+	}
+
+	tags, err := describeAwsLbTags(meta.(*AWSClient).elbv2conn, lb.LoadBalancerArn, meta.(*AWSClient).ignoreTagsConfig)
+	if err != nil {
+		return fmt.Errorf("Error retrieving LB Tags: %s", err)
+	}
+
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags); err != nil {
+		log.Printf("[WARN] Error setting tags for AWS LB (%s): %s", d.Id(), err)
+	}
+
+	return nil
 }
 
 func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -663,6 +683,24 @@ func lbSuffixFromARN(arn *string) string {
 	return ""
 }
 
+// describeAwsLbTags returns the tags of the given ELBv2 resource as a map.
+func describeAwsLbTags(conn *elbv2.ELBV2, arn *string, ignoreConfig *IgnoreTagsConfig) (map[string]string, error) {
+	resp, err := conn.DescribeTags(&elbv2.DescribeTagsInput{
+		ResourceArns: []*string{arn},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range resp.TagDescriptions {
+		if aws.StringValue(t.ResourceArn) == aws.StringValue(arn) {
+			return tagsToMapELBv2(t.Tags, ignoreConfig), nil
+		}
+	}
+
+	return map[string]string{}, nil
+}
+
 // flattenAwsLbResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
 func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBalancer) error {
 	elbconn := meta.(*AWSClient).elbv2conn
@@ -686,22 +724,6 @@ func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.Lo
 		return fmt.Errorf("error setting subnet_mapping: %s", err)
 	}
 
-	respTags, err := elbconn.DescribeTags(&elbv2.DescribeTagsInput{
-		ResourceArns: []*string{lb.LoadBalancerArn},
-	})
-	if err != nil {
-		return fmt.Errorf("Error retrieving LB Tags: %s", err)
-	}
-
-	var et []*elbv2.Tag
-	if len(respTags.TagDescriptions) > 0 {
-		et = respTags.TagDescriptions[0].Tags
-	}
-
-	if err := d.Set("tags", tagsToMapELBv2(et)); err != nil {
-		log.Printf("[WARN] Error setting tags for AWS LB (%s): %s", d.Id(), err)
-	}
-
 	attributesResp, err := elbconn.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{
 		LoadBalancerArn: aws.String(d.Id()),
 	})
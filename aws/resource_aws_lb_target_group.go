@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -18,7 +19,10 @@ import (
 func resourceAwsLbTargetGroup() *schema.Resource {
 	return &schema.Resource{
 		// NLBs have restrictions on them at this time
-		CustomizeDiff: resourceAwsLbTargetGroupCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			resourceAwsLbTargetGroupCustomizeDiff,
+		),
 
 		Create: resourceAwsLbTargetGroupCreate,
 		Read:   resourceAwsLbTargetGroupRead,
@@ -228,7 +232,8 @@ func resourceAwsLbTargetGroup() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -338,7 +343,20 @@ func resourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error retrieving Target Group %q", d.Id())
 	}
 
-	return flattenAwsLbTargetGroupResource(d, meta, resp.TargetGroups[0])
+	if err := flattenAwsLbTargetGroupResource(d, meta, resp.TargetGroups[0]); err != nil {
+		return err
+	}
+
+	tags, err := describeAwsLbTags(elbconn, aws.String(d.Id()), meta.(*AWSClient).ignoreTagsConfig)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Target Group Tags: %s", err)
+	}
+
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
 
 func resourceAwsLbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -625,19 +643,6 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 		}
 	}
 
-	tagsResp, err := elbconn.DescribeTags(&elbv2.DescribeTagsInput{
-		ResourceArns: []*string{aws.String(d.Id())},
-	})
-	if err != nil {
-		return fmt.Errorf("Error retrieving Target Group Tags: %s", err)
-	}
-	for _, t := range tagsResp.TagDescriptions {
-		if aws.StringValue(t.ResourceArn) == d.Id() {
-			if err := d.Set("tags", tagsToMapELBv2(t.Tags)); err != nil {
-				return fmt.Errorf("error setting tags: %s", err)
-			}
-		}
-	}
 
 	return nil
 }
@@ -20,6 +20,7 @@ func resourceAwsLicenseManagerLicenseConfiguration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -59,7 +60,8 @@ func resourceAwsLicenseManagerLicenseConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -88,7 +90,7 @@ func resourceAwsLicenseManagerLicenseConfigurationCreate(d *schema.ResourceData,
 		opts.LicenseRules = expandStringList(v.([]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
 		opts.Tags = tagsFromMapLicenseManager(v.(map[string]interface{}))
 	}
 
@@ -127,7 +129,7 @@ func resourceAwsLicenseManagerLicenseConfigurationRead(d *schema.ResourceData, m
 	}
 	d.Set("name", resp.Name)
 
-	if err := d.Set("tags", tagsToMapLicenseManager(resp.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapLicenseManager(resp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -139,11 +141,12 @@ func resourceAwsLicenseManagerLicenseConfigurationUpdate(d *schema.ResourceData,
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsLicenseManager(conn, d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -24,6 +24,7 @@ func resourceAwsLightsailInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -111,7 +112,8 @@ func resourceAwsLightsailInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -135,7 +137,7 @@ func resourceAwsLightsailInstanceCreate(d *schema.ResourceData, meta interface{}
 		req.UserData = aws.String(v.(string))
 	}
 
-	tags := tagsFromMapLightsail(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapLightsail(d.Get("tags_all").(map[string]interface{}))
 
 	if len(tags) != 0 {
 		req.Tags = tags
@@ -214,7 +216,7 @@ func resourceAwsLightsailInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("private_ip_address", i.PrivateIpAddress)
 	d.Set("public_ip_address", i.PublicIpAddress)
 
-	if err := d.Set("tags", tagsToMapLightsail(i.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapLightsail(i.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("Error setting tags: %s", err)
 	}
 
@@ -255,11 +257,12 @@ func resourceAwsLightsailInstanceDelete(d *schema.ResourceData, meta interface{}
 func resourceAwsLightsailInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsLightsail(conn, d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return resourceAwsLightsailInstanceRead(d, meta)
@@ -21,6 +21,7 @@ func resourceAwsMediaPackageChannel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -66,7 +67,8 @@ func resourceAwsMediaPackageChannel() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -79,7 +81,7 @@ func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface
 		Description: aws.String(d.Get("description").(string)),
 	}
 
-	if attr, ok := d.GetOk("tags"); ok {
+	if attr, ok := d.GetOk("tags_all"); ok {
 		input.Tags = tagsFromMapGeneric(attr.(map[string]interface{}))
 	}
 
@@ -110,7 +112,7 @@ func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting hls_ingest: %s", err)
 	}
 
-	if err := d.Set("tags", tagsToMapGeneric(resp.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapGeneric(resp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
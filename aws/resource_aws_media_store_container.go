@@ -22,6 +22,7 @@ func resourceAwsMediaStoreContainer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -37,7 +38,8 @@ func resourceAwsMediaStoreContainer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -47,7 +49,7 @@ func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface
 
 	input := &mediastore.CreateContainerInput{
 		ContainerName: aws.String(d.Get("name").(string)),
-		Tags:          tagsFromMapMediaStore(d.Get("tags").(map[string]interface{})),
+		Tags:          tagsFromMapMediaStore(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	_, err := conn.CreateContainer(input)
@@ -91,7 +93,7 @@ func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}
 	d.Set("name", resp.Container.Name)
 	d.Set("endpoint", resp.Container.Endpoint)
 
-	if err := saveTagsMediaStore(conn, d, aws.StringValue(resp.Container.ARN)); err != nil {
+	if err := saveTagsMediaStore(conn, d, aws.StringValue(resp.Container.ARN), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		if isAWSErr(err, mediastore.ErrCodeContainerNotFoundException, "") {
 			log.Printf("[WARN] No Container found: %s, removing from state", d.Id())
 			d.SetId("")
@@ -17,10 +17,11 @@ import (
 
 func resourceAwsMqBroker() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsMqBrokerCreate,
-		Read:   resourceAwsMqBrokerRead,
-		Update: resourceAwsMqBrokerUpdate,
-		Delete: resourceAwsMqBrokerDelete,
+		Create:        resourceAwsMqBrokerCreate,
+		Read:          resourceAwsMqBrokerRead,
+		Update:        resourceAwsMqBrokerUpdate,
+		Delete:        resourceAwsMqBrokerDelete,
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"apply_immediately": {
@@ -203,7 +204,8 @@ func resourceAwsMqBroker() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -238,7 +240,7 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("subnet_ids"); ok {
 		input.SubnetIds = expandStringList(v.(*schema.Set).List())
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
@@ -280,6 +282,22 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mqconn
 
+	out, err := readAwsMqBroker(d, conn)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return getTagsMQ(conn, d, aws.StringValue(out.BrokerArn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
+}
+
+// readAwsMqBroker populates all non-tag attributes of the MQ Broker, shared
+// with the aws_mq_broker data source. A nil response without error means the
+// broker no longer exists and has been removed from state.
+func readAwsMqBroker(d *schema.ResourceData, conn *mq.MQ) (*mq.DescribeBrokerResponse, error) {
 	log.Printf("[INFO] Reading MQ Broker: %s", d.Id())
 	out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
 		BrokerId: aws.String(d.Id()),
@@ -288,15 +306,15 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		if isAWSErr(err, "NotFoundException", "") {
 			log.Printf("[WARN] MQ Broker %q not found, removing from state", d.Id())
 			d.SetId("")
-			return nil
+			return nil, nil
 		}
 		// API docs say a 404 can also return a 403
 		if isAWSErr(err, "ForbiddenException", "Forbidden") {
 			log.Printf("[WARN] MQ Broker %q not found, removing from state", d.Id())
 			d.SetId("")
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 
 	d.Set("auto_minor_version_upgrade", out.AutoMinorVersionUpgrade)
@@ -310,18 +328,18 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("publicly_accessible", out.PubliclyAccessible)
 	err = d.Set("maintenance_window_start_time", flattenMqWeeklyStartTime(out.MaintenanceWindowStartTime))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	d.Set("security_groups", aws.StringValueSlice(out.SecurityGroups))
 	d.Set("subnet_ids", aws.StringValueSlice(out.SubnetIds))
 
 	if err := d.Set("logs", flattenMqLogs(out.Logs)); err != nil {
-		return fmt.Errorf("error setting logs: %s", err)
+		return nil, fmt.Errorf("error setting logs: %s", err)
 	}
 
 	err = d.Set("configuration", flattenMqConfigurationId(out.Configurations.Current))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rawUsers := make([]*mq.User, len(out.Users))
@@ -331,7 +349,7 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 			Username: u.Username,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		rawUsers[i] = &mq.User{
@@ -343,10 +361,10 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 
 	users := flattenMqUsers(rawUsers, d.Get("user").(*schema.Set).List())
 	if err = d.Set("user", users); err != nil {
-		return err
+		return nil, err
 	}
 
-	return getTagsMQ(conn, d, aws.StringValue(out.BrokerArn))
+	return out, nil
 }
 
 func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
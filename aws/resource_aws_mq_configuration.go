@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -19,20 +20,23 @@ func resourceAwsMqConfiguration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
-			if diff.HasChange("description") {
-				return diff.SetNewComputed("latest_revision")
-			}
-			if diff.HasChange("data") {
-				o, n := diff.GetChange("data")
-				os := o.(string)
-				ns := n.(string)
-				if !suppressXMLEquivalentConfig("data", os, ns, nil) {
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				if diff.HasChange("description") {
 					return diff.SetNewComputed("latest_revision")
 				}
-			}
-			return nil
-		},
+				if diff.HasChange("data") {
+					o, n := diff.GetChange("data")
+					os := o.(string)
+					ns := n.(string)
+					if !suppressXMLEquivalentConfig("data", os, ns, nil) {
+						return diff.SetNewComputed("latest_revision")
+					}
+				}
+				return nil
+			},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -67,7 +71,8 @@ func resourceAwsMqConfiguration() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -81,7 +86,7 @@ func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{})
 		Name:          aws.String(d.Get("name").(string)),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
@@ -135,7 +140,7 @@ func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("data", string(b))
 
-	return getTagsMQ(conn, d, aws.StringValue(out.Arn))
+	return getTagsMQ(conn, d, aws.StringValue(out.Arn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 }
 
 func resourceAwsMqConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -22,6 +22,7 @@ func resourceAwsNatGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
@@ -51,7 +52,8 @@ func resourceAwsNatGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -126,7 +128,7 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_ip", address.PublicIp)
 
 	// Tags
-	d.Set("tags", tagsToMap(ng.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(ng.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -141,6 +143,7 @@ func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsNatGatewayRead(d, meta)
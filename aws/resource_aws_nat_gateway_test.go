@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"strings"
 	"testing"
 
@@ -11,49 +10,48 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
-		Name: "aws_nat_gateway",
-		F:    testSweepNatGateways,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_nat_gateway",
+		Description: "EC2 NAT Gateway",
+		List:        testSweepNatGateways,
 	})
 }
 
-func testSweepNatGateways(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).ec2conn
-
-	req := &ec2.DescribeNatGatewaysInput{}
-	resp, err := conn.DescribeNatGateways(req)
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 NAT Gateway sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing NAT Gateways: %s", err)
-	}
+func testSweepNatGateways(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.ec2conn
+	var resources []*testSweepResource
 
-	if len(resp.NatGateways) == 0 {
-		log.Print("[DEBUG] No AWS NAT Gateways to sweep")
-		return nil
-	}
+	err := conn.DescribeNatGatewaysPages(&ec2.DescribeNatGatewaysInput{}, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		for _, natGateway := range page.NatGateways {
+			if natGateway == nil || aws.StringValue(natGateway.State) == ec2.NatGatewayStateDeleted {
+				continue
+			}
 
-	for _, natGateway := range resp.NatGateways {
-		_, err := conn.DeleteNatGateway(&ec2.DeleteNatGatewayInput{
-			NatGatewayId: natGateway.NatGatewayId,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting NAT Gateway (%s): %s",
-				*natGateway.NatGatewayId, err)
+			input := &ec2.DeleteNatGatewayInput{
+				NatGatewayId: natGateway.NatGatewayId,
+			}
+			tags := keyvaluetags.Ec2KeyValueTags(natGateway.Tags).Map()
+
+			resources = append(resources, &testSweepResource{
+				ID:        aws.StringValue(natGateway.NatGatewayId),
+				Name:      tags["Name"],
+				Tags:      tags,
+				CreatedAt: natGateway.CreateTime,
+				Delete: func() error {
+					_, err := conn.DeleteNatGateway(input)
+					return err
+				},
+			})
 		}
-	}
 
-	return nil
+		return !lastPage
+	})
+
+	return resources, err
 }
 
 func TestAccAWSNatGateway_basic(t *testing.T) {
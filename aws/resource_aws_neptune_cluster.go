@@ -23,6 +23,7 @@ func resourceAwsNeptuneCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Update: schema.DefaultTimeout(120 * time.Minute),
@@ -222,7 +223,8 @@ func resourceAwsNeptuneCluster() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
@@ -237,7 +239,7 @@ func resourceAwsNeptuneCluster() *schema.Resource {
 
 func resourceAwsNeptuneClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).neptuneconn
-	tags := tagsFromMapNeptune(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapNeptune(d.Get("tags_all").(map[string]interface{}))
 
 	// Check if any of the parameters that require a cluster modification after creation are set
 	clusterUpdate := false
@@ -484,7 +486,7 @@ func flattenAwsNeptuneClusterResource(d *schema.ResourceData, meta interface{},
 	arn := aws.StringValue(dbc.DBClusterArn)
 	d.Set("arn", arn)
 
-	if err := saveTagsNeptune(conn, d, arn); err != nil {
+	if err := saveTagsNeptune(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return fmt.Errorf("Failed to save tags for Neptune Cluster (%s): %s", aws.StringValue(dbc.DBClusterIdentifier), err)
 	}
 
@@ -606,6 +608,7 @@ func resourceAwsNeptuneClusterUpdate(d *schema.ResourceData, meta interface{}) e
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -20,6 +20,7 @@ func resourceAwsNeptuneEventSubscription() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
@@ -77,7 +78,8 @@ func resourceAwsNeptuneEventSubscription() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -93,7 +95,7 @@ func resourceAwsNeptuneEventSubscriptionCreate(d *schema.ResourceData, meta inte
 		d.Set("name", resource.PrefixedUniqueId("tf-"))
 	}
 
-	tags := tagsFromMapNeptune(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapNeptune(d.Get("tags_all").(map[string]interface{}))
 
 	request := &neptune.CreateEventSubscriptionInput{
 		SubscriptionName: aws.String(d.Get("name").(string)),
@@ -189,7 +191,7 @@ func resourceAwsNeptuneEventSubscriptionRead(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if err := saveTagsNeptune(conn, d, aws.StringValue(sub.EventSubscriptionArn)); err != nil {
+	if err := saveTagsNeptune(conn, d, aws.StringValue(sub.EventSubscriptionArn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return fmt.Errorf("Error saving tags for Neptune Event Subscription (%s): %s", d.Id(), err)
 	}
 
@@ -265,6 +267,7 @@ func resourceAwsNeptuneEventSubscriptionUpdate(d *schema.ResourceData, meta inte
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("source_ids") {
@@ -27,6 +27,7 @@ func resourceAwsNeptuneParameterGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -76,7 +77,8 @@ func resourceAwsNeptuneParameterGroup() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -168,7 +170,7 @@ func resourceAwsNeptuneParameterGroupRead(d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG] Error retrieving tags for ARN: %s", arn)
 	}
 
-	if err := d.Set("tags", tagsToMapNeptune(resp.TagList)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapNeptune(resp.TagList, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting neptune tags: %s", err)
 	}
 
@@ -259,12 +261,13 @@ func resourceAwsNeptuneParameterGroupUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("parameter")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsNeptune(conn, d, d.Get("arn").(string))
 		if err != nil {
 			return fmt.Errorf("error setting Neptune Parameter Group %q tags: %s", d.Id(), err)
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -27,6 +27,7 @@ func resourceAwsNetworkAcl() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsNetworkAclImportState,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -147,7 +148,8 @@ func resourceAwsNetworkAcl() *schema.Resource {
 				},
 				Set: resourceAwsNetworkAclEntryHash,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -222,7 +224,7 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("vpc_id", networkAcl.VpcId)
-	d.Set("tags", tagsToMap(networkAcl.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(networkAcl.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	d.Set("owner_id", networkAcl.OwnerId)
 
 	var s []string
@@ -326,6 +328,7 @@ func resourceAwsNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -11,95 +11,71 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
-		Name: "aws_network_acl",
-		F:    testSweepNetworkAcls,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_network_acl",
+		Description: "EC2 Network ACL",
+		List:        testSweepNetworkAcls,
 	})
 }
 
-func testSweepNetworkAcls(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).ec2conn
-
-	req := &ec2.DescribeNetworkAclsInput{}
-	resp, err := conn.DescribeNetworkAcls(req)
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 Network ACL sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing Network ACLs: %s", err)
-	}
-
-	if len(resp.NetworkAcls) == 0 {
-		log.Print("[DEBUG] No Network ACLs to sweep")
-		return nil
-	}
+func testSweepNetworkAcls(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.ec2conn
+	var resources []*testSweepResource
 
-	for _, nacl := range resp.NetworkAcls {
-		// Delete rules first
-		for _, entry := range nacl.Entries {
-			// These are the rule numbers for IPv4 and IPv6 "ALL traffic" rules which cannot be deleted
-			if aws.Int64Value(entry.RuleNumber) == 32767 || aws.Int64Value(entry.RuleNumber) == 32768 {
-				log.Printf("[DEBUG] Skipping Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
+	err := conn.DescribeNetworkAclsPages(&ec2.DescribeNetworkAclsInput{}, func(page *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
+		for _, nacl := range page.NetworkAcls {
+			if nacl == nil {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
-			_, err := conn.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{
-				NetworkAclId: nacl.NetworkAclId,
-				Egress:       entry.Egress,
-				RuleNumber:   entry.RuleNumber,
-			})
-			if err != nil {
-				return fmt.Errorf(
-					"Error deleting Network ACL rule (%s / %d): %s",
-					*nacl.NetworkAclId, *entry.RuleNumber, err)
+			// Default Network ACLs will be deleted along with VPC
+			if aws.BoolValue(nacl.IsDefault) {
+				log.Printf("[DEBUG] Skipping default Network ACL: %s", aws.StringValue(nacl.NetworkAclId))
+				continue
 			}
-		}
 
-		// Disassociate subnets
-		log.Printf("[DEBUG] Found %d Network ACL associations for %q", len(nacl.Associations), *nacl.NetworkAclId)
-		for _, a := range nacl.Associations {
-			log.Printf("[DEBUG] Replacing subnet associations for Network ACL %q", *nacl.NetworkAclId)
-			defaultAcl, err := getDefaultNetworkAcl(*nacl.VpcId, conn)
-			if err != nil {
-				return fmt.Errorf("Failed to find default Network ACL for VPC %q", *nacl.VpcId)
-			}
-			_, err = conn.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
-				NetworkAclId:  defaultAcl.NetworkAclId,
-				AssociationId: a.NetworkAclAssociationId,
+			nacl := nacl
+			tags := keyvaluetags.Ec2KeyValueTags(nacl.Tags).Map()
+
+			resources = append(resources, &testSweepResource{
+				ID:   aws.StringValue(nacl.NetworkAclId),
+				Name: tags["Name"],
+				Tags: tags,
+				Delete: func() error {
+					// Move any subnet associations back to the VPC's default Network ACL
+					if len(nacl.Associations) > 0 {
+						defaultAcl, err := getDefaultNetworkAcl(aws.StringValue(nacl.VpcId), conn)
+						if err != nil {
+							return fmt.Errorf("error finding default Network ACL for VPC (%s): %s", aws.StringValue(nacl.VpcId), err)
+						}
+
+						for _, a := range nacl.Associations {
+							_, err := conn.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
+								NetworkAclId:  defaultAcl.NetworkAclId,
+								AssociationId: a.NetworkAclAssociationId,
+							})
+							if err != nil {
+								return fmt.Errorf("error replacing subnet association (%s): %s", aws.StringValue(a.NetworkAclAssociationId), err)
+							}
+						}
+					}
+
+					_, err := conn.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{
+						NetworkAclId: nacl.NetworkAclId,
+					})
+					return err
+				},
 			})
-			if err != nil {
-				return fmt.Errorf("Failed to replace subnet association for Network ACL %q: %s",
-					*nacl.NetworkAclId, err)
-			}
-		}
-
-		// Default Network ACLs will be deleted along with VPC
-		if *nacl.IsDefault {
-			log.Printf("[DEBUG] Skipping default Network ACL: %q", *nacl.NetworkAclId)
-			continue
 		}
 
-		log.Printf("[INFO] Deleting Network ACL: %q", *nacl.NetworkAclId)
-		_, err := conn.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{
-			NetworkAclId: nacl.NetworkAclId,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Network ACL (%s): %s",
-				*nacl.NetworkAclId, err)
-		}
-	}
+		return !lastPage
+	})
 
-	return nil
+	return resources, err
 }
 
 func TestAccAWSNetworkAcl_importBasic(t *testing.T) {
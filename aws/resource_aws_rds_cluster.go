@@ -24,6 +24,7 @@ func resourceAwsRDSCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsRdsClusterImport,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -224,6 +225,7 @@ func resourceAwsRDSCluster() *schema.Resource {
 				MaxItems: 1,
 				ConflictsWith: []string{
 					"snapshot_identifier",
+					"restore_to_point_in_time",
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -300,6 +302,52 @@ func resourceAwsRDSCluster() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"restore_to_point_in_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"s3_import",
+					"snapshot_identifier",
+					"replication_source_identifier",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restore_to_time": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validateUTCTimestamp,
+							ConflictsWith: []string{"restore_to_point_in_time.0.use_latest_restorable_time"},
+						},
+
+						"restore_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"copy-on-write",
+								"full-copy",
+							}, false),
+						},
+
+						"source_cluster_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"use_latest_restorable_time": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_to_point_in_time.0.restore_to_time"},
+						},
+					},
+				},
+			},
+
 			"port": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -401,7 +449,8 @@ func resourceAwsRDSCluster() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -417,7 +466,7 @@ func resourceAwsRdsClusterImport(
 
 func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	// Some API calls (e.g. RestoreDBClusterFromSnapshot do not support all
 	// parameters to correctly apply all settings in one pass. For missing
@@ -742,6 +791,75 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 			return err
 		}
 
+	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
+		input := expandRdsClusterRestoreToPointInTime(v.([]interface{}))
+		input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
+		input.DBClusterIdentifier = aws.String(identifier)
+		input.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
+		input.Tags = tags
+
+		// Need to check value > 0 due to:
+		// InvalidParameterValue: Backtrack is not enabled for the aurora-postgresql engine.
+		if v, ok := d.GetOk("backtrack_window"); ok && v.(int) > 0 {
+			input.BacktrackWindow = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("db_cluster_parameter_group_name"); ok {
+			input.DBClusterParameterGroupName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("db_subnet_group_name"); ok {
+			input.DBSubnetGroupName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && len(v.([]interface{})) > 0 {
+			input.EnableCloudwatchLogsExports = expandStringList(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+			input.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("kms_key_id"); ok {
+			input.KmsKeyId = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("option_group_name"); ok {
+			input.OptionGroupName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("port"); ok {
+			input.Port = aws.Int64(int64(v.(int)))
+		}
+
+		if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
+			input.VpcSecurityGroupIds = expandStringSet(v)
+		}
+
+		if v, ok := d.GetOk("backup_retention_period"); ok {
+			modifyDbClusterInput.BackupRetentionPeriod = aws.Int64(int64(v.(int)))
+			requiresModifyDbCluster = true
+		}
+
+		if v, ok := d.GetOk("master_password"); ok {
+			modifyDbClusterInput.MasterUserPassword = aws.String(v.(string))
+			requiresModifyDbCluster = true
+		}
+
+		if v, ok := d.GetOk("preferred_backup_window"); ok {
+			modifyDbClusterInput.PreferredBackupWindow = aws.String(v.(string))
+			requiresModifyDbCluster = true
+		}
+
+		if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+			modifyDbClusterInput.PreferredMaintenanceWindow = aws.String(v.(string))
+			requiresModifyDbCluster = true
+		}
+
+		log.Printf("[DEBUG] RDS Cluster restore to point in time configuration: %s", input)
+		if _, err := conn.RestoreDBClusterToPointInTime(input); err != nil {
+			return fmt.Errorf("error creating RDS Cluster: %s", err)
+		}
 	} else {
 
 		if _, ok := d.GetOk("global_cluster_identifier"); !ok {
@@ -1031,7 +1149,7 @@ func resourceAwsRDSClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Fetch and save tags
-	if err := saveTagsRDS(conn, d, aws.StringValue(dbc.DBClusterArn)); err != nil {
+	if err := saveTagsRDS(conn, d, aws.StringValue(dbc.DBClusterArn), meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		log.Printf("[WARN] Failed to save tags for RDS Cluster (%s): %s", aws.StringValue(dbc.DBClusterIdentifier), err)
 	}
 
@@ -1220,11 +1338,12 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -1399,3 +1518,32 @@ func waitForRDSClusterDeletion(conn *rds.RDS, id string, timeout time.Duration)
 
 	return err
 }
+
+func expandRdsClusterRestoreToPointInTime(l []interface{}) *rds.RestoreDBClusterToPointInTimeInput {
+	input := &rds.RestoreDBClusterToPointInTimeInput{}
+
+	if len(l) == 0 || l[0] == nil {
+		return input
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["restore_to_time"].(string); ok && v != "" {
+		parsedTime, _ := time.Parse(time.RFC3339, v)
+		input.RestoreToTime = aws.Time(parsedTime)
+	}
+
+	if v, ok := m["restore_type"].(string); ok && v != "" {
+		input.RestoreType = aws.String(v)
+	}
+
+	if v, ok := m["source_cluster_identifier"].(string); ok && v != "" {
+		input.SourceDBClusterIdentifier = aws.String(v)
+	}
+
+	if v, ok := m["use_latest_restorable_time"].(bool); ok && v {
+		input.UseLatestRestorableTime = aws.Bool(v)
+	}
+
+	return input
+}
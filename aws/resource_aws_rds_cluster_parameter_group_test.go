@@ -18,34 +18,27 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
-		Name: "aws_rds_cluster_parameter_group",
-		F:    testSweepRdsClusterParameterGroups,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_rds_cluster_parameter_group",
+		Description: "RDS DB Cluster Parameter Group",
 		Dependencies: []string{
 			"aws_rds_cluster",
 		},
+		List: testSweepRdsClusterParameterGroups,
 	})
 }
 
-func testSweepRdsClusterParameterGroups(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).rdsconn
+func testSweepRdsClusterParameterGroups(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.rdsconn
+	var resources []*testSweepResource
 
 	input := &rds.DescribeDBClusterParameterGroupsInput{}
 
 	for {
 		output, err := conn.DescribeDBClusterParameterGroups(input)
 
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping RDS DB Cluster Parameter Group sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error retrieving DB Cluster Parameter Groups: %s", err)
+			return nil, err
 		}
 
 		for _, dbcpg := range output.DBClusterParameterGroups {
@@ -53,9 +46,6 @@ func testSweepRdsClusterParameterGroups(region string) error {
 				continue
 			}
 
-			input := &rds.DeleteDBClusterParameterGroupInput{
-				DBClusterParameterGroupName: dbcpg.DBClusterParameterGroupName,
-			}
 			name := aws.StringValue(dbcpg.DBClusterParameterGroupName)
 
 			if strings.HasPrefix(name, "default.") {
@@ -63,14 +53,18 @@ func testSweepRdsClusterParameterGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting DB Cluster Parameter Group: %s", name)
-
-			_, err := conn.DeleteDBClusterParameterGroup(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB Cluster Parameter Group %s: %s", name, err)
-				continue
+			input := &rds.DeleteDBClusterParameterGroupInput{
+				DBClusterParameterGroupName: dbcpg.DBClusterParameterGroupName,
 			}
+
+			resources = append(resources, &testSweepResource{
+				ID:   name,
+				Name: name,
+				Delete: func() error {
+					_, err := conn.DeleteDBClusterParameterGroup(input)
+					return err
+				},
+			})
 		}
 
 		if aws.StringValue(output.Marker) == "" {
@@ -80,7 +74,7 @@ func testSweepRdsClusterParameterGroups(region string) error {
 		input.Marker = output.Marker
 	}
 
-	return nil
+	return resources, nil
 }
 
 func TestAccAWSDBClusterParameterGroup_importBasic(t *testing.T) {
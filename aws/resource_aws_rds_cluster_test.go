@@ -19,85 +19,78 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
-		Name: "aws_rds_cluster",
-		F:    testSweepRdsClusters,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_rds_cluster",
+		Description: "RDS DB Cluster",
 		Dependencies: []string{
 			"aws_db_instance",
 		},
+		List: testSweepRdsClusters,
 	})
 }
 
-func testSweepRdsClusters(region string) error {
-	client, err := sharedClientForRegion(region)
+func testSweepRdsClusters(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.rdsconn
+	var resources []*testSweepResource
 
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-
-	conn := client.(*AWSClient).rdsconn
-	input := &rds.DescribeDBClustersInput{}
-
-	err = conn.DescribeDBClustersPages(input, func(out *rds.DescribeDBClustersOutput, lastPage bool) bool {
+	err := conn.DescribeDBClustersPages(&rds.DescribeDBClustersInput{}, func(out *rds.DescribeDBClustersOutput, lastPage bool) bool {
 		for _, cluster := range out.DBClusters {
+			if cluster == nil {
+				continue
+			}
+
+			cluster := cluster
 			id := aws.StringValue(cluster.DBClusterIdentifier)
 
-			// Automatically remove from global cluster to bypass this error on deletion:
-			// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
-			if aws.StringValue(cluster.EngineMode) == "global" {
-				globalCluster, err := rdsDescribeGlobalClusterFromDbClusterARN(conn, aws.StringValue(cluster.DBClusterArn))
+			resources = append(resources, &testSweepResource{
+				ID:        id,
+				Name:      id,
+				CreatedAt: cluster.ClusterCreateTime,
+				Delete: func() error {
+					// Automatically remove from global cluster to bypass this error on deletion:
+					// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
+					if aws.StringValue(cluster.EngineMode) == "global" {
+						globalCluster, err := rdsDescribeGlobalClusterFromDbClusterARN(conn, aws.StringValue(cluster.DBClusterArn))
+
+						if err != nil {
+							return fmt.Errorf("error reading RDS Global Cluster information: %s", err)
+						}
+
+						if globalCluster != nil {
+							globalClusterID := aws.StringValue(globalCluster.GlobalClusterIdentifier)
+							input := &rds.RemoveFromGlobalClusterInput{
+								DbClusterIdentifier:     cluster.DBClusterArn,
+								GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
+							}
+
+							log.Printf("[INFO] Removing RDS Cluster (%s) from RDS Global Cluster: %s", id, globalClusterID)
+							if _, err := conn.RemoveFromGlobalCluster(input); err != nil {
+								return fmt.Errorf("error removing from RDS Global Cluster (%s): %s", globalClusterID, err)
+							}
+						}
+					}
 
-				if err != nil {
-					log.Printf("[ERROR] Failure reading RDS Global Cluster information for DB Cluster (%s): %s", id, err)
-				}
+					_, err := conn.DeleteDBCluster(&rds.DeleteDBClusterInput{
+						DBClusterIdentifier: aws.String(id),
+						SkipFinalSnapshot:   aws.Bool(true),
+					})
 
-				if globalCluster != nil {
-					globalClusterID := aws.StringValue(globalCluster.GlobalClusterIdentifier)
-					input := &rds.RemoveFromGlobalClusterInput{
-						DbClusterIdentifier:     cluster.DBClusterArn,
-						GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
+					if isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
+						return nil
 					}
 
-					log.Printf("[INFO] Removing RDS Cluster (%s) from RDS Global Cluster: %s", id, globalClusterID)
-					_, err = conn.RemoveFromGlobalCluster(input)
-
 					if err != nil {
-						log.Printf("[ERROR] Failure removing RDS Cluster (%s) from RDS Global Cluster (%s): %s", id, globalClusterID, err)
+						return err
 					}
-				}
-			}
-
-			input := &rds.DeleteDBClusterInput{
-				DBClusterIdentifier: cluster.DBClusterIdentifier,
-				SkipFinalSnapshot:   aws.Bool(true),
-			}
-
-			log.Printf("[INFO] Deleting RDS DB Cluster: %s", id)
-
-			_, err := conn.DeleteDBCluster(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS DB Cluster (%s): %s", id, err)
-				continue
-			}
 
-			if err := waitForRDSClusterDeletion(conn, id, 40*time.Minute); err != nil {
-				log.Printf("[ERROR] Failure while waiting for RDS DB Cluster (%s) to be deleted: %s", id, err)
-			}
+					return waitForRDSClusterDeletion(conn, id, 40*time.Minute)
+				},
+			})
 		}
 		return !lastPage
 	})
 
-	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS DB Cluster sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error retrieving RDS DB Clusters: %s", err)
-	}
-
-	return nil
+	return resources, err
 }
 
 func TestAccAWSRDSCluster_importBasic(t *testing.T) {
@@ -1077,6 +1070,32 @@ func TestAccAWSRDSCluster_ScalingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccAWSRDSCluster_RestoreToPointInTime(t *testing.T) {
+	var dbCluster, sourceDbCluster rds.DBCluster
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	sourceResourceName := "aws_rds_cluster.source"
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRDSClusterConfig_RestoreToPointInTime(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSClusterExists(sourceResourceName, &sourceDbCluster),
+					testAccCheckAWSClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "restore_to_point_in_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restore_to_point_in_time.0.restore_type", "copy-on-write"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_to_point_in_time.0.source_cluster_identifier", sourceResourceName, "cluster_identifier"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSRDSCluster_SnapshotIdentifier(t *testing.T) {
 	var dbCluster, sourceDbCluster rds.DBCluster
 	var dbClusterSnapshot rds.DBClusterSnapshot
@@ -2636,6 +2655,28 @@ resource "aws_rds_cluster" "test" {
 `, rName, autoPause, maxCapacity, minCapacity, secondsUntilAutoPause, timeoutAction)
 }
 
+func testAccAWSRDSClusterConfig_RestoreToPointInTime(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "source" {
+  cluster_identifier  = "%[1]s-source"
+  master_password     = "barbarbarbar"
+  master_username     = "foo"
+  skip_final_snapshot = true
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[1]q
+  skip_final_snapshot = true
+
+  restore_to_point_in_time {
+    source_cluster_identifier  = "${aws_rds_cluster.source.cluster_identifier}"
+    restore_type               = "copy-on-write"
+    use_latest_restorable_time = true
+  }
+}
+`, rName)
+}
+
 func testAccAWSRDSClusterConfig_SnapshotIdentifier(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "source" {
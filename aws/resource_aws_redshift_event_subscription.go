@@ -20,6 +20,7 @@ func resourceAwsRedshiftEventSubscription() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
 			Delete: schema.DefaultTimeout(40 * time.Minute),
@@ -74,7 +75,8 @@ func resourceAwsRedshiftEventSubscription() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -90,7 +92,7 @@ func resourceAwsRedshiftEventSubscriptionCreate(d *schema.ResourceData, meta int
 		SourceType:       aws.String(d.Get("source_type").(string)),
 		Severity:         aws.String(d.Get("severity").(string)),
 		EventCategories:  expandStringSet(d.Get("event_categories").(*schema.Set)),
-		Tags:             tagsFromMapRedshift(d.Get("tags").(map[string]interface{})),
+		Tags:             tagsFromMapRedshift(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Println("[DEBUG] Create Redshift Event Subscription:", request)
@@ -155,7 +157,7 @@ func resourceAwsRedshiftEventSubscriptionRead(d *schema.ResourceData, meta inter
 	if err := d.Set("customer_aws_id", sub.CustomerAwsId); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsToMapRedshift(sub.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapRedshift(sub.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -209,6 +211,7 @@ func resourceAwsRedshiftEventSubscriptionUpdate(d *schema.ResourceData, meta int
 		return tagErr
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
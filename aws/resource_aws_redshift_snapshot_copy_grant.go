@@ -16,11 +16,12 @@ func resourceAwsRedshiftSnapshotCopyGrant() *schema.Resource {
 	return &schema.Resource{
 		// There is no API for updating/modifying grants, hence no Update
 		// Instead changes to most fields will force a new resource
-		Create: resourceAwsRedshiftSnapshotCopyGrantCreate,
-		Read:   resourceAwsRedshiftSnapshotCopyGrantRead,
-		Update: resourceAwsRedshiftSnapshotCopyGrantUpdate,
-		Delete: resourceAwsRedshiftSnapshotCopyGrantDelete,
-		Exists: resourceAwsRedshiftSnapshotCopyGrantExists,
+		Create:        resourceAwsRedshiftSnapshotCopyGrantCreate,
+		Read:          resourceAwsRedshiftSnapshotCopyGrantRead,
+		Update:        resourceAwsRedshiftSnapshotCopyGrantUpdate,
+		Delete:        resourceAwsRedshiftSnapshotCopyGrantDelete,
+		CustomizeDiff: setTagsDiff,
+		Exists:        resourceAwsRedshiftSnapshotCopyGrantExists,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -38,7 +39,8 @@ func resourceAwsRedshiftSnapshotCopyGrant() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -56,7 +58,7 @@ func resourceAwsRedshiftSnapshotCopyGrantCreate(d *schema.ResourceData, meta int
 		input.KmsKeyId = aws.String(v.(string))
 	}
 
-	input.Tags = tagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
+	input.Tags = tagsFromMapRedshift(d.Get("tags_all").(map[string]interface{}))
 
 	log.Printf("[DEBUG]: Adding new Redshift SnapshotCopyGrant: %s", input)
 
@@ -104,7 +106,7 @@ func resourceAwsRedshiftSnapshotCopyGrantRead(d *schema.ResourceData, meta inter
 
 	d.Set("kms_key_id", grant.KmsKeyId)
 	d.Set("snapshot_copy_grant_name", grant.SnapshotCopyGrantName)
-	if err := d.Set("tags", tagsToMapRedshift(grant.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapRedshift(grant.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("Error setting Redshift Snapshot Copy Grant Tags: %#v", err)
 	}
 
@@ -120,6 +122,7 @@ func resourceAwsRedshiftSnapshotCopyGrantUpdate(d *schema.ResourceData, meta int
 		return tagErr
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -23,6 +23,7 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"type": {
@@ -132,7 +133,8 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -375,7 +377,7 @@ func resourceAwsRoute53HealthCheckRead(d *schema.ResourceData, meta interface{})
 		tags = resp.ResourceTagSet.Tags
 	}
 
-	if err := d.Set("tags", tagsToMapR53(tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapR53(tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -27,6 +27,7 @@ func resourceAwsRoute53ResolverEndpoint() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"direction": {
@@ -81,7 +82,8 @@ func resourceAwsRoute53ResolverEndpoint() *schema.Resource {
 				ValidateFunc: validateRoute53ResolverName,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"arn": {
 				Type:     schema.TypeString,
@@ -114,7 +116,7 @@ func resourceAwsRoute53ResolverEndpointCreate(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("name"); ok {
 		req.Name = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
 		req.Tags = tagsFromMapRoute53Resolver(v.(map[string]interface{}))
 	}
 
@@ -179,7 +181,7 @@ func resourceAwsRoute53ResolverEndpointRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	if err := getTagsRoute53Resolver(conn, d); err != nil {
+	if err := getTagsRoute53Resolver(conn, d, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig); err != nil {
 		return fmt.Errorf("error getting Route53 Resolver endpoint (%s) tags: %s", d.Id(), err)
 	}
 
@@ -261,6 +263,7 @@ func resourceAwsRoute53ResolverEndpointUpdate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error setting Route53 Resolver endpoint (%s) tags: %s", d.Id(), err)
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsRoute53ResolverEndpointRead(d, meta)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform/helper/customdiff"
 )
 
 const (
@@ -21,11 +22,14 @@ const (
 
 func resourceAwsRoute53ResolverRule() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceAwsRoute53ResolverRuleCreate,
-		Read:          resourceAwsRoute53ResolverRuleRead,
-		Update:        resourceAwsRoute53ResolverRuleUpdate,
-		Delete:        resourceAwsRoute53ResolverRuleDelete,
-		CustomizeDiff: resourceAwsRoute53ResolverRuleCustomizeDiff,
+		Create: resourceAwsRoute53ResolverRuleCreate,
+		Read:   resourceAwsRoute53ResolverRuleRead,
+		Update: resourceAwsRoute53ResolverRuleUpdate,
+		Delete: resourceAwsRoute53ResolverRuleDelete,
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			resourceAwsRoute53ResolverRuleCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -88,7 +92,8 @@ func resourceAwsRoute53ResolverRule() *schema.Resource {
 				Set: route53ResolverRuleHashTargetIp,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"arn": {
 				Type:     schema.TypeString,
@@ -125,7 +130,7 @@ func resourceAwsRoute53ResolverRuleCreate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("target_ip"); ok {
 		req.TargetIps = expandRoute53ResolverRuleTargetIps(v.(*schema.Set))
 	}
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
 		req.Tags = tagsFromMapRoute53Resolver(v.(map[string]interface{}))
 	}
 
@@ -172,7 +177,7 @@ func resourceAwsRoute53ResolverRuleRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	err = getTagsRoute53Resolver(conn, d)
+	err = getTagsRoute53Resolver(conn, d, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).defaultTagsConfig)
 	if err != nil {
 		return fmt.Errorf("Error reading Route 53 Resolver rule tags %s: %s", d.Id(), err)
 	}
@@ -221,6 +226,7 @@ func resourceAwsRoute53ResolverRuleUpdate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error setting Route53 Resolver rule (%s) tags: %s", d.Id(), err)
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsRoute53ResolverRuleRead(d, meta)
@@ -25,6 +25,7 @@ func resourceAwsRoute53Zone() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -96,7 +97,8 @@ func resourceAwsRoute53Zone() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"force_destroy": {
 				Type:     schema.TypeBool,
@@ -242,7 +244,7 @@ func resourceAwsRoute53ZoneRead(d *schema.ResourceData, meta interface{}) error
 		tags = resp.ResourceTagSet.Tags
 	}
 
-	if err := d.Set("tags", tagsToMapR53(tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapR53(tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -270,12 +272,14 @@ func resourceAwsRoute53ZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("comment")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsR53(conn, d, route53.TagResourceTypeHostedzone); err != nil {
 			return err
 		}
 
 		d.SetPartial("tags")
+
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("vpc") {
@@ -23,6 +23,7 @@ func resourceAwsRouteTable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -31,7 +32,8 @@ func resourceAwsRouteTable() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"propagating_vgws": {
 				Type:     schema.TypeSet,
@@ -219,7 +221,7 @@ func resourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("route", route)
 
 	// Tags
-	d.Set("tags", tagsToMap(rt.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(rt.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	d.Set("owner_id", rt.OwnerId)
 
@@ -404,6 +406,7 @@ func resourceAwsRouteTableUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return resourceAwsRouteTableRead(d, meta)
@@ -11,25 +11,27 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
-		Name: "aws_route_table",
-		F:    testSweepRouteTables,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_route_table",
+		Description: "EC2 Route Table",
+		List:        testSweepRouteTables,
 	})
 }
 
-func testSweepRouteTables(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).ec2conn
+func testSweepRouteTables(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.ec2conn
+	var resources []*testSweepResource
 
-	input := &ec2.DescribeRouteTablesInput{}
-	err = conn.DescribeRouteTablesPages(input, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
+	err := conn.DescribeRouteTablesPages(&ec2.DescribeRouteTablesInput{}, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
 		for _, routeTable := range page.RouteTables {
+			if routeTable == nil {
+				continue
+			}
+
 			isMainRouteTableAssociation := false
 
 			for _, routeTableAssociation := range routeTable.Associations {
@@ -37,16 +39,6 @@ func testSweepRouteTables(region string) error {
 					isMainRouteTableAssociation = true
 					break
 				}
-
-				input := &ec2.DisassociateRouteTableInput{
-					AssociationId: routeTableAssociation.RouteTableAssociationId,
-				}
-
-				log.Printf("[DEBUG] Deleting Route Table Association: %s", input)
-				_, err := conn.DisassociateRouteTable(input)
-				if err != nil {
-					log.Printf("[ERROR] Error deleting Route Table Association (%s): %s", aws.StringValue(routeTableAssociation.RouteTableAssociationId), err)
-				}
 			}
 
 			if isMainRouteTableAssociation {
@@ -54,30 +46,43 @@ func testSweepRouteTables(region string) error {
 				continue
 			}
 
-			input := &ec2.DeleteRouteTableInput{
-				RouteTableId: routeTable.RouteTableId,
-			}
-
-			log.Printf("[DEBUG] Deleting Route Table: %s", input)
-			_, err := conn.DeleteRouteTable(input)
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Route Table (%s): %s", aws.StringValue(routeTable.RouteTableId), err)
-			}
+			routeTable := routeTable
+			tags := keyvaluetags.Ec2KeyValueTags(routeTable.Tags).Map()
+
+			resources = append(resources, &testSweepResource{
+				ID:   aws.StringValue(routeTable.RouteTableId),
+				Name: tags["Name"],
+				Tags: tags,
+				Delete: func() error {
+					for _, routeTableAssociation := range routeTable.Associations {
+						input := &ec2.DisassociateRouteTableInput{
+							AssociationId: routeTableAssociation.RouteTableAssociationId,
+						}
+
+						log.Printf("[DEBUG] Deleting Route Table Association: %s", input)
+						_, err := conn.DisassociateRouteTable(input)
+
+						if isAWSErr(err, "InvalidAssociationID.NotFound", "") {
+							continue
+						}
+
+						if err != nil {
+							return fmt.Errorf("error deleting Route Table Association (%s): %s", aws.StringValue(routeTableAssociation.RouteTableAssociationId), err)
+						}
+					}
+
+					_, err := conn.DeleteRouteTable(&ec2.DeleteRouteTableInput{
+						RouteTableId: routeTable.RouteTableId,
+					})
+					return err
+				},
+			})
 		}
 
 		return !lastPage
 	})
 
-	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Route Table sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("Error describing Route Tables: %s", err)
-	}
-
-	return nil
+	return resources, err
 }
 
 func TestAccAWSRouteTable_basic(t *testing.T) {
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAclPut,
+		Read:   resourceAwsS3BucketAclRead,
+		Update: resourceAwsS3BucketAclPut,
+		Delete: resourceAwsS3BucketAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsS3BucketAclImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"acl": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(s3BucketCannedAcls, false),
+			},
+		},
+	}
+}
+
+// s3BucketCannedAcls are the canned ACLs which can be applied to a bucket.
+// The S3 API model only enumerates four bucket canned ACLs. S3 also accepts
+// the aws-exec-read, bucket-owner-read and bucket-owner-full-control object
+// canned ACLs for buckets, as its documentation lists, where the
+// bucket-owner-* ACLs grant the same permissions as private. The
+// log-delivery-write canned ACL only applies to buckets but is missing from
+// the model.
+var s3BucketCannedAcls = []string{
+	s3.BucketCannedACLPrivate,
+	s3.BucketCannedACLPublicRead,
+	s3.BucketCannedACLPublicReadWrite,
+	s3.BucketCannedACLAuthenticatedRead,
+	"aws-exec-read",
+	"bucket-owner-read",
+	"bucket-owner-full-control",
+	"log-delivery-write",
+}
+
+func resourceAwsS3BucketAclPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+		ACL:    aws.String(d.Get("acl").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket ACL: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketAcl(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket (%s) ACL: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+	return resourceAwsS3BucketAclRead(d, meta)
+}
+
+func resourceAwsS3BucketAclRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	resp, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketAcl(&s3.GetBucketAclInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing ACL from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) ACL: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	// S3 does not report which canned ACL was applied, so it is derived
+	// from the grants where possible
+	output := resp.(*s3.GetBucketAclOutput)
+	acl := s3BucketCannedAclFromGrants(output.Owner, output.Grants)
+	configuredAcl := d.Get("acl").(string)
+
+	switch {
+	case acl == "":
+		log.Printf("[DEBUG] S3 bucket (%s) ACL grants do not match a canned ACL, e.g. aws-exec-read", d.Id())
+	case acl == s3.BucketCannedACLPrivate && (configuredAcl == "bucket-owner-read" || configuredAcl == "bucket-owner-full-control"):
+		// The bucket owner canned ACLs grant a bucket the same permissions as private
+	default:
+		d.Set("acl", acl)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAclImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := s3conn.GetBucketAcl(&s3.GetBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading S3 bucket (%s) ACL: %s", d.Id(), err)
+	}
+
+	// acl is Required, so the import fails rather than leaving it empty
+	acl := s3BucketCannedAclFromGrants(output.Owner, output.Grants)
+
+	if acl == "" {
+		return nil, fmt.Errorf("S3 bucket (%s) ACL grants do not match a canned ACL which can be imported: %s, %s, %s, %s or log-delivery-write",
+			d.Id(), s3.BucketCannedACLPrivate, s3.BucketCannedACLPublicRead, s3.BucketCannedACLPublicReadWrite, s3.BucketCannedACLAuthenticatedRead)
+	}
+
+	d.Set("acl", acl)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// s3BucketCannedAclFromGrants returns the canned ACL which grants a bucket's
+// owner full control and the given grants to other grantees, or an empty
+// string if the grants do not match a canned ACL which can be derived.
+func s3BucketCannedAclFromGrants(owner *s3.Owner, grants []*s3.Grant) string {
+	const (
+		allUsersURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+		authenticatedUsersURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+		logDeliveryURI        = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+	)
+
+	var ownerFullControl bool
+	others := make(map[string]bool)
+
+	for _, grant := range grants {
+		if grant == nil || grant.Grantee == nil {
+			continue
+		}
+
+		permission := aws.StringValue(grant.Permission)
+
+		if owner != nil && aws.StringValue(grant.Grantee.ID) == aws.StringValue(owner.ID) && aws.StringValue(grant.Grantee.Type) == s3.TypeCanonicalUser {
+			if permission == s3.PermissionFullControl {
+				ownerFullControl = true
+				continue
+			}
+		}
+
+		switch aws.StringValue(grant.Grantee.URI) {
+		case allUsersURI, authenticatedUsersURI, logDeliveryURI:
+			others[aws.StringValue(grant.Grantee.URI)+" "+permission] = true
+		default:
+			return ""
+		}
+	}
+
+	if !ownerFullControl {
+		return ""
+	}
+
+	cannedAcls := []struct {
+		acl    string
+		grants []string
+	}{
+		{
+			acl: s3.BucketCannedACLPrivate,
+		},
+		{
+			acl:    s3.BucketCannedACLPublicRead,
+			grants: []string{allUsersURI + " " + s3.PermissionRead},
+		},
+		{
+			acl:    s3.BucketCannedACLPublicReadWrite,
+			grants: []string{allUsersURI + " " + s3.PermissionRead, allUsersURI + " " + s3.PermissionWrite},
+		},
+		{
+			acl:    s3.BucketCannedACLAuthenticatedRead,
+			grants: []string{authenticatedUsersURI + " " + s3.PermissionRead},
+		},
+		{
+			acl:    "log-delivery-write",
+			grants: []string{logDeliveryURI + " " + s3.PermissionWrite, logDeliveryURI + " " + s3.PermissionReadAcp},
+		},
+	}
+
+	for _, cannedAcl := range cannedAcls {
+		if len(cannedAcl.grants) != len(others) {
+			continue
+		}
+
+		matches := true
+		for _, grant := range cannedAcl.grants {
+			if !others[grant] {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return cannedAcl.acl
+		}
+	}
+
+	return ""
+}
+
+func resourceAwsS3BucketAclDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	// A bucket always has an ACL, so reset it to the S3 default.
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(d.Id()),
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+	}
+
+	log.Printf("[DEBUG] Resetting S3 bucket ACL: %s", input)
+	_, err := s3conn.PutBucketAcl(input)
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resetting S3 bucket (%s) ACL: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketAcl_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	resourceName := "aws_s3_bucket_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAclConfig(name, "public-read"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAclGrantsAllUsersRead(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "bucket", name),
+					resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketAclConfig(name, "private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAclGrantsAllUsersRead(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "acl", "private"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAcl_importAwsExecRead(t *testing.T) {
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	resourceName := "aws_s3_bucket_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAclConfig(name, "aws-exec-read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "acl", "aws-exec-read"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`ACL grants do not match a canned ACL which can be imported`),
+			},
+		},
+	})
+}
+
+func TestS3BucketCannedAclFromGrants(t *testing.T) {
+	owner := &s3.Owner{ID: aws.String("owner")}
+	ownerGrant := &s3.Grant{
+		Grantee:    &s3.Grantee{ID: aws.String("owner"), Type: aws.String(s3.TypeCanonicalUser)},
+		Permission: aws.String(s3.PermissionFullControl),
+	}
+	groupGrant := func(uri, permission string) *s3.Grant {
+		return &s3.Grant{
+			Grantee:    &s3.Grantee{URI: aws.String(uri), Type: aws.String(s3.TypeGroup)},
+			Permission: aws.String(permission),
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Grants   []*s3.Grant
+		Expected string
+	}{
+		{
+			Name:     "private",
+			Grants:   []*s3.Grant{ownerGrant},
+			Expected: s3.BucketCannedACLPrivate,
+		},
+		{
+			Name:     "public-read",
+			Grants:   []*s3.Grant{ownerGrant, groupGrant("http://acs.amazonaws.com/groups/global/AllUsers", s3.PermissionRead)},
+			Expected: s3.BucketCannedACLPublicRead,
+		},
+		{
+			Name: "public-read-write",
+			Grants: []*s3.Grant{
+				ownerGrant,
+				groupGrant("http://acs.amazonaws.com/groups/global/AllUsers", s3.PermissionRead),
+				groupGrant("http://acs.amazonaws.com/groups/global/AllUsers", s3.PermissionWrite),
+			},
+			Expected: s3.BucketCannedACLPublicReadWrite,
+		},
+		{
+			Name:     "authenticated-read",
+			Grants:   []*s3.Grant{ownerGrant, groupGrant("http://acs.amazonaws.com/groups/global/AuthenticatedUsers", s3.PermissionRead)},
+			Expected: s3.BucketCannedACLAuthenticatedRead,
+		},
+		{
+			Name: "log-delivery-write",
+			Grants: []*s3.Grant{
+				ownerGrant,
+				groupGrant("http://acs.amazonaws.com/groups/s3/LogDelivery", s3.PermissionWrite),
+				groupGrant("http://acs.amazonaws.com/groups/s3/LogDelivery", s3.PermissionReadAcp),
+			},
+			Expected: "log-delivery-write",
+		},
+		{
+			Name: "other canonical user",
+			Grants: []*s3.Grant{
+				ownerGrant,
+				{
+					Grantee:    &s3.Grantee{ID: aws.String("other"), Type: aws.String(s3.TypeCanonicalUser)},
+					Permission: aws.String(s3.PermissionRead),
+				},
+			},
+			Expected: "",
+		},
+		{
+			Name:     "other group permission",
+			Grants:   []*s3.Grant{ownerGrant, groupGrant("http://acs.amazonaws.com/groups/global/AllUsers", s3.PermissionWriteAcp)},
+			Expected: "",
+		},
+		{
+			Name:     "no owner full control",
+			Grants:   []*s3.Grant{groupGrant("http://acs.amazonaws.com/groups/global/AllUsers", s3.PermissionRead)},
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := s3BucketCannedAclFromGrants(owner, testCase.Grants); got != testCase.Expected {
+				t.Errorf("expected %q, got %q", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func testAccCheckAWSS3BucketAclGrantsAllUsersRead(n string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := conn.GetBucketAcl(&s3.GetBucketAclInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		var found bool
+		for _, grant := range output.Grants {
+			if grant.Grantee != nil && aws.StringValue(grant.Grantee.URI) == "http://acs.amazonaws.com/groups/global/AllUsers" &&
+				aws.StringValue(grant.Permission) == s3.PermissionRead {
+				found = true
+			}
+		}
+
+		if found != expected {
+			return fmt.Errorf("S3 bucket (%s) AllUsers READ grant: expected %t, got %t", rs.Primary.ID, expected, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketAclConfig(bucketName, acl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_acl" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+  acl    = %[2]q
+}
+`, bucketName, acl)
+}
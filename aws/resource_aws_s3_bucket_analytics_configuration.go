@@ -0,0 +1,385 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketAnalyticsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAnalyticsConfigurationPut,
+		Read:   resourceAwsS3BucketAnalyticsConfigurationRead,
+		Update: resourceAwsS3BucketAnalyticsConfigurationPut,
+		Delete: resourceAwsS3BucketAnalyticsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"storage_class_analysis": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_export": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_bucket_destination": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_account_id": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validateAwsAccountId,
+															},
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"format": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Default:  s3.AnalyticsS3ExportFileFormatCsv,
+																ValidateFunc: validation.StringInSlice([]string{
+																	s3.AnalyticsS3ExportFileFormatCsv,
+																}, false),
+															},
+															"prefix": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"output_schema_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  s3.StorageClassAnalysisSchemaVersionV1,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3.StorageClassAnalysisSchemaVersionV1,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	analyticsConfiguration := &s3.AnalyticsConfiguration{
+		Id:                   aws.String(name),
+		StorageClassAnalysis: expandS3StorageClassAnalysis(d.Get("storage_class_analysis").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		filterList := v.([]interface{})
+		if filterMap, ok := filterList[0].(map[string]interface{}); ok {
+			analyticsConfiguration.Filter = expandS3AnalyticsFilter(filterMap)
+		}
+	}
+
+	input := &s3.PutBucketAnalyticsConfigurationInput{
+		Bucket:                 aws.String(bucket),
+		Id:                     aws.String(name),
+		AnalyticsConfiguration: analyticsConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket analytics configuration: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketAnalyticsConfiguration(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 analytics configuration: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+
+	return resourceAwsS3BucketAnalyticsConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("name", name)
+
+	input := &s3.GetBucketAnalyticsConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+	output, err := conn.GetBucketAnalyticsConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "The specified configuration does not exist.") {
+			log.Printf("[WARN] %s S3 bucket analytics configuration not found, removing from state.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading S3 analytics configuration: %s", err)
+	}
+
+	if output.AnalyticsConfiguration == nil {
+		log.Printf("[WARN] %s S3 bucket analytics configuration not found, removing from state.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var filter []interface{}
+	if output.AnalyticsConfiguration.Filter != nil {
+		filter = []interface{}{flattenS3AnalyticsFilter(output.AnalyticsConfiguration.Filter)}
+	}
+	if err := d.Set("filter", filter); err != nil {
+		return fmt.Errorf("error setting filter: %s", err)
+	}
+
+	if err := d.Set("storage_class_analysis", flattenS3StorageClassAnalysis(output.AnalyticsConfiguration.StorageClassAnalysis)); err != nil {
+		return fmt.Errorf("error setting storage_class_analysis: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &s3.DeleteBucketAnalyticsConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket analytics configuration: %s", input)
+	_, err = conn.DeleteBucketAnalyticsConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "The specified configuration does not exist.") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting S3 analytics configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("please make sure the ID is in the form BUCKET:NAME (i.e. my-bucket:EntireBucket")
+	}
+	bucket := idParts[0]
+	name := idParts[1]
+	return bucket, name, nil
+}
+
+func expandS3AnalyticsFilter(m map[string]interface{}) *s3.AnalyticsFilter {
+	var prefix string
+	if v, ok := m["prefix"]; ok {
+		prefix = v.(string)
+	}
+
+	var tags []*s3.Tag
+	if v, ok := m["tags"]; ok {
+		tags = tagsFromMapS3(v.(map[string]interface{}))
+	}
+
+	if prefix == "" && len(tags) == 0 {
+		return nil
+	}
+
+	analyticsFilter := &s3.AnalyticsFilter{}
+	if prefix != "" && len(tags) > 0 {
+		analyticsFilter.And = &s3.AnalyticsAndOperator{
+			Prefix: aws.String(prefix),
+			Tags:   tags,
+		}
+	} else if len(tags) > 1 {
+		analyticsFilter.And = &s3.AnalyticsAndOperator{
+			Tags: tags,
+		}
+	} else if len(tags) == 1 {
+		analyticsFilter.Tag = tags[0]
+	} else {
+		analyticsFilter.Prefix = aws.String(prefix)
+	}
+	return analyticsFilter
+}
+
+func expandS3StorageClassAnalysis(l []interface{}) *s3.StorageClassAnalysis {
+	result := &s3.StorageClassAnalysis{}
+
+	if len(l) == 0 || l[0] == nil {
+		return result
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["data_export"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		dataExport := &s3.StorageClassAnalysisDataExport{}
+		result.DataExport = dataExport
+
+		dem := v.([]interface{})[0].(map[string]interface{})
+		if v, ok := dem["output_schema_version"]; ok {
+			dataExport.OutputSchemaVersion = aws.String(v.(string))
+		}
+
+		dataExport.Destination = expandS3AnalyticsExportDestination(dem["destination"].([]interface{}))
+	}
+
+	return result
+}
+
+func expandS3AnalyticsExportDestination(l []interface{}) *s3.AnalyticsExportDestination {
+	result := &s3.AnalyticsExportDestination{}
+
+	if len(l) == 0 || l[0] == nil {
+		return result
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["s3_bucket_destination"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		bdm := v.([]interface{})[0].(map[string]interface{})
+
+		s3BucketDestination := &s3.AnalyticsS3BucketDestination{
+			Bucket: aws.String(bdm["bucket_arn"].(string)),
+			Format: aws.String(bdm["format"].(string)),
+		}
+		if v := bdm["bucket_account_id"].(string); v != "" {
+			s3BucketDestination.BucketAccountId = aws.String(v)
+		}
+		if v := bdm["prefix"].(string); v != "" {
+			s3BucketDestination.Prefix = aws.String(v)
+		}
+
+		result.S3BucketDestination = s3BucketDestination
+	}
+
+	return result
+}
+
+func flattenS3AnalyticsFilter(analyticsFilter *s3.AnalyticsFilter) map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if analyticsFilter.And != nil {
+		and := *analyticsFilter.And
+		if and.Prefix != nil {
+			m["prefix"] = *and.Prefix
+		}
+		if and.Tags != nil {
+			m["tags"] = tagsToMapS3(and.Tags, nil)
+		}
+	} else if analyticsFilter.Prefix != nil {
+		m["prefix"] = *analyticsFilter.Prefix
+	} else if analyticsFilter.Tag != nil {
+		tags := []*s3.Tag{
+			analyticsFilter.Tag,
+		}
+		m["tags"] = tagsToMapS3(tags, nil)
+	}
+	return m
+}
+
+func flattenS3StorageClassAnalysis(storageClassAnalysis *s3.StorageClassAnalysis) []interface{} {
+	if storageClassAnalysis == nil || storageClassAnalysis.DataExport == nil {
+		return []interface{}{}
+	}
+
+	dataExport := storageClassAnalysis.DataExport
+	de := map[string]interface{}{
+		"output_schema_version": aws.StringValue(dataExport.OutputSchemaVersion),
+	}
+
+	if dataExport.Destination != nil && dataExport.Destination.S3BucketDestination != nil {
+		bucketDestination := dataExport.Destination.S3BucketDestination
+		bd := map[string]interface{}{
+			"bucket_account_id": aws.StringValue(bucketDestination.BucketAccountId),
+			"bucket_arn":        aws.StringValue(bucketDestination.Bucket),
+			"format":            aws.StringValue(bucketDestination.Format),
+			"prefix":            aws.StringValue(bucketDestination.Prefix),
+		}
+
+		de["destination"] = []interface{}{
+			map[string]interface{}{
+				"s3_bucket_destination": []interface{}{bd},
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"data_export": []interface{}{de},
+		},
+	}
+}
@@ -0,0 +1,508 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandS3AnalyticsFilter(t *testing.T) {
+	testCases := []struct {
+		Config                    map[string]interface{}
+		ExpectedS3AnalyticsFilter *s3.AnalyticsFilter
+	}{
+		{
+			Config:                    map[string]interface{}{},
+			ExpectedS3AnalyticsFilter: nil,
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3AnalyticsFilter(tc.Config)
+
+		if !reflect.DeepEqual(value, tc.ExpectedS3AnalyticsFilter) {
+			t.Errorf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, value, tc.ExpectedS3AnalyticsFilter)
+		}
+	}
+}
+
+func TestFlattenS3StorageClassAnalysis(t *testing.T) {
+	testCases := []struct {
+		StorageClassAnalysis *s3.StorageClassAnalysis
+		Expected             []interface{}
+	}{
+		{
+			StorageClassAnalysis: nil,
+			Expected:             []interface{}{},
+		},
+		{
+			StorageClassAnalysis: &s3.StorageClassAnalysis{},
+			Expected:             []interface{}{},
+		},
+		{
+			StorageClassAnalysis: &s3.StorageClassAnalysis{
+				DataExport: &s3.StorageClassAnalysisDataExport{
+					OutputSchemaVersion: aws.String(s3.StorageClassAnalysisSchemaVersionV1),
+					Destination: &s3.AnalyticsExportDestination{
+						S3BucketDestination: &s3.AnalyticsS3BucketDestination{
+							Bucket:          aws.String("arn:aws:s3:::destination"),
+							BucketAccountId: aws.String("123456789012"),
+							Format:          aws.String(s3.AnalyticsS3ExportFileFormatCsv),
+							Prefix:          aws.String("analytics/"),
+						},
+					},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"data_export": []interface{}{
+						map[string]interface{}{
+							"output_schema_version": s3.StorageClassAnalysisSchemaVersionV1,
+							"destination": []interface{}{
+								map[string]interface{}{
+									"s3_bucket_destination": []interface{}{
+										map[string]interface{}{
+											"bucket_account_id": "123456789012",
+											"bucket_arn":        "arn:aws:s3:::destination",
+											"format":            s3.AnalyticsS3ExportFileFormatCsv,
+											"prefix":            "analytics/",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := flattenS3StorageClassAnalysis(tc.StorageClassAnalysis)
+
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Errorf("Case #%d: Given:\n%#v\n\nExpected:\n%#v", i, value, tc.Expected)
+		}
+	}
+}
+
+func TestResourceAwsS3BucketAnalyticsConfigurationParseID(t *testing.T) {
+	validIds := []string{
+		"foo:bar",
+		"my-bucket:entire-bucket",
+	}
+
+	for _, s := range validIds {
+		_, _, err := resourceAwsS3BucketAnalyticsConfigurationParseID(s)
+		if err != nil {
+			t.Fatalf("%s should be a valid S3 bucket analytics configuration id: %s", s, err)
+		}
+	}
+
+	invalidIds := []string{
+		"",
+		"foo",
+		"foo:",
+		":bar",
+		"foo:bar:baz",
+		"foo.bar",
+	}
+
+	for _, s := range invalidIds {
+		_, _, err := resourceAwsS3BucketAnalyticsConfigurationParseID(s)
+		if err == nil {
+			t.Fatalf("%s should not be a valid S3 bucket analytics configuration id", s)
+		}
+	}
+}
+
+func TestResourceAwsS3BucketAnalyticsConfigurationRead_notFound(t *testing.T) {
+	m := newTestMockAws(t, "s3")
+	defer m.Close(t)
+
+	m.On("s3", "GET /tf-test-bucket").
+		RespondError(http.StatusNotFound, "NoSuchConfiguration", "The specified configuration does not exist.")
+
+	d := schema.TestResourceDataRaw(t, resourceAwsS3BucketAnalyticsConfiguration().Schema, map[string]interface{}{})
+	d.SetId("tf-test-bucket:tf-test-analytics")
+
+	if err := resourceAwsS3BucketAnalyticsConfigurationRead(d, m.client); err != nil {
+		t.Fatalf("error reading S3 bucket analytics configuration: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected S3 bucket analytics configuration to be removed from state, got ID %q", d.Id())
+	}
+
+	requests := m.Requests("s3", "GET /tf-test-bucket")
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 GetBucketAnalyticsConfiguration request, got %d", len(requests))
+	}
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_basic(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("tf-acc-test-%d", rInt)
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_disappears(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("tf-acc-test-%d", rInt)
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					testAccCheckAWSS3BucketAnalyticsConfigurationDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithFilter(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("tf-acc-test-%d", rInt)
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(rName, rName, "prefix1/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix1/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(rName, rName, "prefix2/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix2/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithStorageClassAnalysis(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("tf-acc-test-%d", rInt)
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.output_schema_version", s3.StorageClassAnalysisSchemaVersionV1),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_arn", "aws_s3_bucket.destination", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.format", s3.AnalyticsS3ExportFileFormatCsv),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "analytics/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_InvalidName(t *testing.T) {
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("tf-acc-test-%d", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketAnalyticsConfiguration(acctest.RandStringFromCharSet(65, acctest.CharSetAlpha), rName),
+				ExpectError: regexp.MustCompile(`expected length of name to be in the range \(1 - 64\)`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_analytics_configuration" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+			input := &s3.GetBucketAnalyticsConfigurationInput{
+				Bucket: aws.String(bucket),
+				Id:     aws.String(name),
+			}
+			log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+			output, err := conn.GetBucketAnalyticsConfiguration(input)
+			if err != nil {
+				if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "The specified configuration does not exist.") {
+					return nil
+				}
+				return resource.NonRetryableError(err)
+			}
+			if output.AnalyticsConfiguration != nil {
+				return resource.RetryableError(fmt.Errorf("S3 bucket analytics configuration exists: %v", output))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationExists(n string, res *s3.AnalyticsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket analytics configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetBucketAnalyticsConfiguration(&s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+
+		*res = *output.AnalyticsConfiguration
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationDisappears(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DeleteBucketAnalyticsConfiguration(&s3.DeleteBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, bucketName)
+}
+
+func testAccAWSS3BucketAnalyticsConfiguration(name, bucketName string) string {
+	return testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName) + fmt.Sprintf(`
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = %[1]q
+}
+`, name)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(name, bucketName, prefix string) string {
+	return testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName) + fmt.Sprintf(`
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = %[1]q
+
+  filter {
+    prefix = %[2]q
+  }
+}
+`, name, prefix)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(name, bucketName, prefix string) string {
+	return testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName) + fmt.Sprintf(`
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = %[1]q
+
+  filter {
+    prefix = %[2]q
+
+    tags = {
+      "tag1" = "value1"
+      "tag2" = "value2"
+    }
+  }
+}
+`, name, prefix)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(name, bucketName string) string {
+	return testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "destination" {
+  bucket = "%[2]s-destination"
+}
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = %[1]q
+
+  storage_class_analysis {
+    data_export {
+      destination {
+        s3_bucket_destination {
+          bucket_arn = "${aws_s3_bucket.destination.arn}"
+          prefix     = "analytics/"
+        }
+      }
+    }
+  }
+}
+`, name, bucketName)
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketCorsConfigurationPut,
+		Read:   resourceAwsS3BucketCorsConfigurationRead,
+		Update: resourceAwsS3BucketCorsConfigurationPut,
+		Delete: resourceAwsS3BucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cors_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     resourceAwsS3BucketCorsRule(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketCorsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandS3CorsRules(d.Get("cors_rule").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket CORS configuration: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketCors(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket (%s) CORS configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+	return resourceAwsS3BucketCorsConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && (isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchCORSConfiguration", "")) {
+		log.Printf("[WARN] S3 Bucket CORS configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) CORS configuration: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("cors_rule", flattenS3CorsRules(output.(*s3.GetBucketCorsOutput).CORSRules)); err != nil {
+		return fmt.Errorf("error setting cors_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 bucket CORS configuration: %s", d.Id())
+	_, err := s3conn.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 bucket (%s) CORS configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLifecycleConfigurationPut,
+		Read:   resourceAwsS3BucketLifecycleConfigurationRead,
+		Update: resourceAwsS3BucketLifecycleConfigurationPut,
+		Delete: resourceAwsS3BucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     resourceAwsS3BucketLifecycleRule(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLifecycleConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	rules, err := expandS3LifecycleRules(d.Get("rule").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket lifecycle configuration: %s", input)
+	_, err = retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketLifecycleConfiguration(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket (%s) lifecycle configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+	return resourceAwsS3BucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && (isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchLifecycleConfiguration", "")) {
+		log.Printf("[WARN] S3 Bucket lifecycle configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) lifecycle configuration: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("rule", flattenS3LifecycleRules(output.(*s3.GetBucketLifecycleConfigurationOutput).Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 bucket lifecycle configuration: %s", d.Id())
+	_, err := s3conn.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 bucket (%s) lifecycle configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
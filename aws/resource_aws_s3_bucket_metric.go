@@ -192,7 +192,7 @@ func flattenS3MetricsFilter(metricsFilter *s3.MetricsFilter) map[string]interfac
 			m["prefix"] = *and.Prefix
 		}
 		if and.Tags != nil {
-			m["tags"] = tagsToMapS3(and.Tags)
+			m["tags"] = tagsToMapS3(and.Tags, nil)
 		}
 	} else if metricsFilter.Prefix != nil {
 		m["prefix"] = *metricsFilter.Prefix
@@ -200,7 +200,7 @@ func flattenS3MetricsFilter(metricsFilter *s3.MetricsFilter) map[string]interfac
 		tags := []*s3.Tag{
 			metricsFilter.Tag,
 		}
-		m["tags"] = tagsToMapS3(tags)
+		m["tags"] = tagsToMapS3(tags, nil)
 	}
 	return m
 }
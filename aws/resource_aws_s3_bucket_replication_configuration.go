@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationPut,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationPut,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      rulesHash,
+				Elem:     resourceAwsS3BucketReplicationRule(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: expandS3ReplicationConfiguration(map[string]interface{}{
+			"role":  d.Get("role"),
+			"rules": d.Get("rules"),
+		}),
+	}
+
+	// Versioning may have just been enabled by aws_s3_bucket_versioning
+	log.Printf("[DEBUG] Putting S3 bucket replication configuration: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		if _, err := s3conn.PutBucketReplication(input); err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") ||
+				isAWSErr(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket (%s) replication configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && (isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "")) {
+		log.Printf("[WARN] S3 Bucket replication configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) replication configuration: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	replication := flattenAwsS3BucketReplicationConfiguration(output.(*s3.GetBucketReplicationOutput).ReplicationConfiguration)
+	if len(replication) == 0 {
+		return fmt.Errorf("error reading S3 bucket (%s) replication configuration: empty response", d.Id())
+	}
+
+	d.Set("role", replication[0]["role"])
+	if err := d.Set("rules", replication[0]["rules"]); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 bucket replication configuration: %s", d.Id())
+	_, err := s3conn.DeleteBucketReplication(&s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 bucket (%s) replication configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
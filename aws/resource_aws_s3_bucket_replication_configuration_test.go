@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketReplicationConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt, s3.StorageClassStandard),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.source", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt, s3.StorageClassStandardIa),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketReplicationConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.ReplicationConfiguration == nil || len(output.ReplicationConfiguration.Rules) == 0 {
+			return fmt.Errorf("S3 bucket (%s) replication configuration has no rules", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketReplicationConfigurationConfig(randInt int, storageClass string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name               = "tf-iam-role-replication-%[1]d"
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "s3.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = "tf-test-bucket-%[1]d"
+}
+
+resource "aws_s3_bucket_versioning" "source" {
+  bucket  = "${aws_s3_bucket.source.id}"
+  enabled = true
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "tf-test-bucket-destination-%[1]d"
+}
+
+resource "aws_s3_bucket_versioning" "destination" {
+  bucket  = "${aws_s3_bucket.destination.id}"
+  enabled = true
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  bucket = "${aws_s3_bucket_versioning.source.bucket}"
+  role   = "${aws_iam_role.test.arn}"
+
+  rules {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket        = "${aws_s3_bucket.destination.arn}"
+      storage_class = %[2]q
+    }
+  }
+
+  depends_on = ["aws_s3_bucket_versioning.destination"]
+}
+`, randInt, storageClass)
+}
@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketServerSideEncryptionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketServerSideEncryptionConfigurationPut,
+		Read:   resourceAwsS3BucketServerSideEncryptionConfigurationRead,
+		Update: resourceAwsS3BucketServerSideEncryptionConfigurationPut,
+		Delete: resourceAwsS3BucketServerSideEncryptionConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     resourceAwsS3BucketServerSideEncryptionRule(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: expandS3ServerSideEncryptionRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket server side encryption configuration: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketEncryption(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket (%s) server side encryption configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+	return resourceAwsS3BucketServerSideEncryptionConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && (isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "")) {
+		log.Printf("[WARN] S3 Bucket server side encryption configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) server side encryption configuration: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	encryption := flattenAwsS3ServerSideEncryptionConfiguration(output.(*s3.GetBucketEncryptionOutput).ServerSideEncryptionConfiguration)
+	if len(encryption) == 0 {
+		return fmt.Errorf("error reading S3 bucket (%s) server side encryption configuration: empty response", d.Id())
+	}
+
+	if err := d.Set("rule", encryption[0]["rule"]); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 bucket server side encryption configuration: %s", d.Id())
+	_, err := s3conn.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 bucket (%s) server side encryption configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
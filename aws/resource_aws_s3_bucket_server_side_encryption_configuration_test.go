@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsS3BucketServerSideEncryptionConfigurationRead_notFound(t *testing.T) {
+	m := newTestMockAws(t, "s3")
+	defer m.Close(t)
+
+	m.On("s3", "GET /tf-test-bucket").
+		RespondError(http.StatusNotFound, "ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found")
+
+	d := schema.TestResourceDataRaw(t, resourceAwsS3BucketServerSideEncryptionConfiguration().Schema, map[string]interface{}{})
+	d.SetId("tf-test-bucket")
+
+	if err := resourceAwsS3BucketServerSideEncryptionConfigurationRead(d, m.client); err != nil {
+		t.Fatalf("error reading S3 bucket server side encryption configuration: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected S3 bucket server side encryption configuration to be removed from state, got ID %q", d.Id())
+	}
+
+	requests := m.Requests("s3", "GET /tf-test-bucket")
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 GetBucketEncryption request, got %d", len(requests))
+	}
+}
+
+func TestAccAWSS3BucketServerSideEncryptionConfiguration_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	resourceName := "aws_s3_bucket_server_side_encryption_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketServerSideEncryptionConfigurationConfig(name, s3.ServerSideEncryptionAes256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketServerSideEncryptionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", name),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.apply_server_side_encryption_by_default.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", s3.ServerSideEncryptionAes256),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketServerSideEncryptionConfigurationConfig(name, s3.ServerSideEncryptionAwsKms),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketServerSideEncryptionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", s3.ServerSideEncryptionAwsKms),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketServerSideEncryptionConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSS3BucketServerSideEncryptionConfigurationConfig(bucketName, sseAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = %[2]q
+    }
+  }
+}
+`, bucketName, sseAlgorithm)
+}
@@ -477,13 +477,15 @@ func TestAccAWSS3Bucket_Website_Simple(t *testing.T) {
 				),
 			},
 			{
+				// website is Computed, so removing it from the configuration
+				// leaves the bucket's website configuration in place
 				Config: testAccAWSS3BucketConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
 					testAccCheckAWSS3BucketWebsite(
-						"aws_s3_bucket.bucket", "", "", "", ""),
+						"aws_s3_bucket.bucket", "index.html", "error.html", "", ""),
 					resource.TestCheckResourceAttr(
-						"aws_s3_bucket.bucket", "website_endpoint", ""),
+						"aws_s3_bucket.bucket", "website_endpoint", testAccWebsiteEndpoint(rInt, region)),
 				),
 			},
 		},
@@ -519,13 +521,15 @@ func TestAccAWSS3Bucket_WebsiteRedirect(t *testing.T) {
 				),
 			},
 			{
+				// website is Computed, so removing it from the configuration
+				// leaves the bucket's website configuration in place
 				Config: testAccAWSS3BucketConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
 					testAccCheckAWSS3BucketWebsite(
-						"aws_s3_bucket.bucket", "", "", "", ""),
+						"aws_s3_bucket.bucket", "", "", "https", "hashicorp.com?my=query"),
 					resource.TestCheckResourceAttr(
-						"aws_s3_bucket.bucket", "website_endpoint", ""),
+						"aws_s3_bucket.bucket", "website_endpoint", testAccWebsiteEndpoint(rInt, region)),
 				),
 			},
 		},
@@ -564,14 +568,22 @@ func TestAccAWSS3Bucket_WebsiteRoutingRules(t *testing.T) {
 				),
 			},
 			{
+				Config: testAccAWSS3BucketWebsiteConfigWithRoutingRulesWebsiteConfiguration(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
+					testAccCheckAWSS3BucketWebsite(
+						"aws_s3_bucket.bucket", "index.html", "error.html", "", ""),
+				),
+			},
+			{
+				// Destroying the aws_s3_bucket_website_configuration resource
+				// removes the website configuration
 				Config: testAccAWSS3BucketConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
 					testAccCheckAWSS3BucketWebsite(
 						"aws_s3_bucket.bucket", "", "", "", ""),
 					testAccCheckAWSS3BucketWebsiteRoutingRules("aws_s3_bucket.bucket", nil),
-					resource.TestCheckResourceAttr(
-						"aws_s3_bucket.bucket", "website_endpoint", ""),
 				),
 			},
 		},
@@ -636,10 +648,19 @@ func TestAccAWSS3Bucket_disableDefaultEncryption_whenDefaultEncryptionIsEnabled(
 				),
 			},
 			{
+				Config: testAccAWSS3BucketEnableDefaultEncryptionWithServerSideEncryptionConfiguration(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.arbitrary"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.arbitrary", "server_side_encryption_configuration.#", "1"),
+				),
+			},
+			{
+				// Destroying the aws_s3_bucket_server_side_encryption_configuration
+				// resource disables default encryption
 				Config: testAccAWSS3BucketDisableDefaultEncryption(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists("aws_s3_bucket.arbitrary"),
-					resource.TestCheckResourceAttr("aws_s3_bucket.arbitrary", "server_side_encryption_configuration.#", "0"),
+					testAccCheckAWSS3BucketNoServerSideEncryption("aws_s3_bucket.arbitrary"),
 				),
 			},
 		},
@@ -1786,6 +1807,27 @@ func testAccCheckAWSS3BucketPolicy(n string, policy string) resource.TestCheckFu
 	}
 }
 
+func testAccCheckAWSS3BucketNoServerSideEncryption(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "") {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 bucket (%s) server side encryption configuration still exists", rs.Primary.ID)
+	}
+}
+
 func testAccCheckAWSS3BucketWebsite(n string, indexDoc string, errorDoc string, redirectProtocol string, redirectTo string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
@@ -2257,6 +2299,21 @@ EOF
 `, randInt)
 }
 
+func testAccAWSS3BucketWebsiteConfigWithRoutingRulesWebsiteConfiguration(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "public-read"
+}
+
+resource "aws_s3_bucket_website_configuration" "bucket" {
+  bucket         = "${aws_s3_bucket.bucket.id}"
+  index_document = "index.html"
+  error_document = "error.html"
+}
+`, randInt)
+}
+
 func testAccAWSS3BucketConfigWithAcceleration(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -2370,6 +2427,24 @@ resource "aws_s3_bucket" "arbitrary" {
 `, randInt)
 }
 
+func testAccAWSS3BucketEnableDefaultEncryptionWithServerSideEncryptionConfiguration(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "arbitrary" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "arbitrary" {
+  bucket = "${aws_s3_bucket.arbitrary.id}"
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "aws:kms"
+    }
+  }
+}
+`, randInt)
+}
+
 func testAccAWSS3BucketDisableDefaultEncryption(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "arbitrary" {
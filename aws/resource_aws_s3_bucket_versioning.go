@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketVersioningPut,
+		Read:   resourceAwsS3BucketVersioningRead,
+		Update: resourceAwsS3BucketVersioningPut,
+		Delete: resourceAwsS3BucketVersioningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"mfa_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketVersioningPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucket),
+		VersioningConfiguration: expandS3VersioningConfiguration(map[string]interface{}{
+			"enabled":    d.Get("enabled"),
+			"mfa_delete": d.Get("mfa_delete"),
+		}),
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket versioning: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketVersioning(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket (%s) versioning: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+	return resourceAwsS3BucketVersioningRead(d, meta)
+}
+
+func resourceAwsS3BucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing versioning from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) versioning: %s", d.Id(), err)
+	}
+
+	vc := flattenS3Versioning(output.(*s3.GetBucketVersioningOutput))
+
+	d.Set("bucket", d.Id())
+	d.Set("enabled", vc["enabled"])
+	d.Set("mfa_delete", vc["mfa_delete"])
+
+	return nil
+}
+
+func resourceAwsS3BucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	// Versioning cannot be removed from a bucket once enabled, only suspended.
+	input := &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(d.Id()),
+		VersioningConfiguration: expandS3VersioningConfiguration(nil),
+	}
+
+	log.Printf("[DEBUG] Suspending S3 bucket versioning: %s", input)
+	_, err := s3conn.PutBucketVersioning(input)
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error suspending S3 bucket (%s) versioning: %s", d.Id(), err)
+	}
+
+	return nil
+}
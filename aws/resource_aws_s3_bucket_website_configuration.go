@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketWebsiteConfigurationPut,
+		Read:   resourceAwsS3BucketWebsiteConfigurationRead,
+		Update: resourceAwsS3BucketWebsiteConfigurationPut,
+		Delete: resourceAwsS3BucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"index_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"error_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"redirect_all_requests_to": {
+				Type: schema.TypeString,
+				ConflictsWith: []string{
+					"index_document",
+					"error_document",
+					"routing_rules",
+				},
+				Optional: true,
+			},
+
+			"routing_rules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketWebsiteConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	websiteConfiguration, err := expandS3WebsiteConfiguration(map[string]interface{}{
+		"index_document":           d.Get("index_document"),
+		"error_document":           d.Get("error_document"),
+		"redirect_all_requests_to": d.Get("redirect_all_requests_to"),
+		"routing_rules":            d.Get("routing_rules"),
+	})
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket website configuration: %s", input)
+	_, err = retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketWebsite(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket (%s) website configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+	return resourceAwsS3BucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && (isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchWebsiteConfiguration", "")) {
+		log.Printf("[WARN] S3 Bucket website configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) website configuration: %s", d.Id(), err)
+	}
+
+	w, err := flattenS3WebsiteConfiguration(output.(*s3.GetBucketWebsiteOutput))
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("index_document", w["index_document"])
+	d.Set("error_document", w["error_document"])
+	d.Set("redirect_all_requests_to", w["redirect_all_requests_to"])
+	d.Set("routing_rules", w["routing_rules"])
+
+	locationResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketLocation(&s3.GetBucketLocationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) location: %s", d.Id(), err)
+	}
+
+	var region string
+	if location, ok := locationResponse.(*s3.GetBucketLocationOutput); ok && location.LocationConstraint != nil {
+		region = *location.LocationConstraint
+	}
+
+	websiteEndpoint := WebsiteEndpoint(d.Id(), region)
+	d.Set("website_endpoint", websiteEndpoint.Endpoint)
+	d.Set("website_domain", websiteEndpoint.Domain)
+
+	return nil
+}
+
+func resourceAwsS3BucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 bucket website configuration: %s", d.Id())
+	_, err := s3conn.DeleteBucketWebsite(&s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 bucket (%s) website configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
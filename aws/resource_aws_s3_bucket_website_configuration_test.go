@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketWebsiteConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-bucket-%d", rInt)
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsiteConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", name),
+					resource.TestCheckResourceAttr(resourceName, "index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "error_document", "error.html"),
+					resource.TestCheckResourceAttr(resourceName, "website_endpoint", testAccWebsiteEndpoint(rInt, testAccGetRegion())),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketWebsiteConfiguration_RedirectAllRequestsTo(t *testing.T) {
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfigRedirectAllRequestsTo(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsiteConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", "https://hashicorp.com?my=query"),
+					resource.TestCheckResourceAttr(resourceName, "index_document", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketWebsiteConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfig(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket         = "${aws_s3_bucket.test.id}"
+  index_document = "index.html"
+  error_document = "error.html"
+}
+`, bucketName)
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfigRedirectAllRequestsTo(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket                   = "${aws_s3_bucket.test.id}"
+  redirect_all_requests_to = "https://hashicorp.com?my=query"
+}
+`, bucketName)
+}
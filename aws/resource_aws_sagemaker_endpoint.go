@@ -19,6 +19,7 @@ func resourceAwsSagemakerEndpoint() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -40,7 +41,8 @@ func resourceAwsSagemakerEndpoint() *schema.Resource {
 				ValidateFunc: validateSagemakerName,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -60,7 +62,7 @@ func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}
 		EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		createOpts.Tags = tagsFromMapSagemaker(v.(map[string]interface{}))
 	}
 
@@ -123,7 +125,7 @@ func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error listing tags for SageMaker Endpoint (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapSagemaker(tagsOutput.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapSagemaker(tagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -139,6 +141,7 @@ func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	if d.HasChange("endpoint_config_name") {
 		modifyOpts := &sagemaker.UpdateEndpointInput{
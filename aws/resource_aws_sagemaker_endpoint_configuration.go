@@ -21,6 +21,7 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -91,7 +92,8 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 				ValidateFunc: validateArn,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -115,7 +117,7 @@ func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, met
 		createOpts.KmsKeyId = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		createOpts.Tags = tagsFromMapSagemaker(v.(map[string]interface{}))
 	}
 
@@ -165,7 +167,7 @@ func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta
 	if err != nil {
 		return fmt.Errorf("error listing tags of SageMaker Endpoint Configuration %s: %s", d.Id(), err)
 	}
-	if err := d.Set("tags", tagsToMapSagemaker(tagsOutput.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapSagemaker(tagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 	return nil
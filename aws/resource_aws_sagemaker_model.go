@@ -21,6 +21,7 @@ func resourceAwsSagemakerModel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -144,7 +145,8 @@ func resourceAwsSagemakerModel() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -177,7 +179,7 @@ func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) e
 		createOpts.SetExecutionRoleArn(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		createOpts.SetTags(tagsFromMapSagemaker(v.(map[string]interface{})))
 	}
 
@@ -262,7 +264,7 @@ func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error listing tags of Sagemaker model %s: %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapSagemaker(tagsOutput.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapSagemaker(tagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 	return nil
@@ -290,6 +292,7 @@ func resourceAwsSagemakerModelUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
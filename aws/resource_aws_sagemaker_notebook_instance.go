@@ -20,6 +20,7 @@ func resourceAwsSagemakerNotebookInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -72,7 +73,8 @@ func resourceAwsSagemakerNotebookInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -101,7 +103,7 @@ func resourceAwsSagemakerNotebookInstanceCreate(d *schema.ResourceData, meta int
 		createOpts.LifecycleConfigName = aws.String(l.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tagsIn := v.(map[string]interface{})
 		createOpts.Tags = tagsFromMapSagemaker(tagsIn)
 	}
@@ -184,7 +186,7 @@ func resourceAwsSagemakerNotebookInstanceRead(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error listing tags for sagemaker notebook instance (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapSagemaker(tagsOutput.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapSagemaker(tagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags for notebook instance (%s): %s", d.Id(), err)
 	}
 	return nil
@@ -199,6 +201,7 @@ func resourceAwsSagemakerNotebookInstanceUpdate(d *schema.ResourceData, meta int
 		return err
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	hasChanged := false
 	// Update
@@ -270,7 +270,7 @@ func testAccCheckAWSSagemakerNotebookInstanceTags(notebook *sagemaker.DescribeNo
 			return fmt.Errorf("Error listing tags: %s", err)
 		}
 
-		m := tagsToMapSagemaker(ts.Tags)
+		m := tagsToMapSagemaker(ts.Tags, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
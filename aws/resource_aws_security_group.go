@@ -28,6 +28,7 @@ func resourceAwsSecurityGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsSecurityGroupImportState,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -220,7 +221,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"revoke_rules_on_delete": {
 				Type:     schema.TypeBool,
@@ -395,7 +397,7 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[WARN] Error setting Egress rule set for (%s): %s", d.Id(), err)
 	}
 
-	d.Set("tags", tagsToMap(sg.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(sg.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	return nil
 }
 
@@ -438,6 +440,7 @@ func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 			return err
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return resourceAwsSecurityGroupRead(d, meta)
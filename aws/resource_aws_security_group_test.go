@@ -3,13 +3,13 @@ package aws
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -18,108 +18,83 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // add sweeper to delete known test sgs
 func init() {
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
-		Name: "aws_security_group",
+	addTestSweeper(&testSweeper{
+		Name:        "aws_security_group",
+		Description: "EC2 Security Group",
 		Dependencies: []string{
 			"aws_subnet",
 		},
-		F: testSweepSecurityGroups,
+		List: testSweepSecurityGroups,
+		// Handle EC2 eventual consistency
+		RetryableErrorCodes: []string{"DependencyViolation"},
 	})
 }
 
-func testSweepSecurityGroups(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).ec2conn
+func testSweepSecurityGroups(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.ec2conn
+	var resources []*testSweepResource
 
-	input := &ec2.DescribeSecurityGroupsInput{}
-
-	// Delete all non-default EC2 Security Group Rules to prevent DependencyViolation errors
-	err = conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+	err := conn.DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{}, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
 		for _, sg := range page.SecurityGroups {
-			if aws.StringValue(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(sg.GroupId))
+			if sg == nil {
 				continue
 			}
 
-			if sg.IpPermissions != nil {
-				req := &ec2.RevokeSecurityGroupIngressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissions,
-				}
-
-				if _, err = conn.RevokeSecurityGroupIngress(req); err != nil {
-					log.Printf("[ERROR] Error revoking ingress rule for Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-				}
-			}
-
-			if sg.IpPermissionsEgress != nil {
-				req := &ec2.RevokeSecurityGroupEgressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissionsEgress,
-				}
-
-				if _, err = conn.RevokeSecurityGroupEgress(req); err != nil {
-					log.Printf("[ERROR] Error revoking egress rule for Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-				}
-			}
-		}
-
-		return !lastPage
-	})
-
-	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Security Group sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Security Groups: %s", err)
-	}
-
-	err = conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
-		for _, sg := range page.SecurityGroups {
 			if aws.StringValue(sg.GroupName) == "default" {
 				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(sg.GroupId))
 				continue
 			}
 
-			input := &ec2.DeleteSecurityGroupInput{
-				GroupId: sg.GroupId,
-			}
+			sg := sg
+
+			resources = append(resources, &testSweepResource{
+				ID:   aws.StringValue(sg.GroupId),
+				Name: aws.StringValue(sg.GroupName),
+				Tags: keyvaluetags.Ec2KeyValueTags(sg.Tags).Map(),
+				// Delete all non-default EC2 Security Group Rules to prevent DependencyViolation errors
+				Prepare: func() error {
+					if len(sg.IpPermissions) > 0 {
+						req := &ec2.RevokeSecurityGroupIngressInput{
+							GroupId:       sg.GroupId,
+							IpPermissions: sg.IpPermissions,
+						}
 
-			// Handle EC2 eventual consistency
-			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteSecurityGroup(input)
+						if _, err := conn.RevokeSecurityGroupIngress(req); err != nil {
+							return fmt.Errorf("error revoking ingress rules: %s", err)
+						}
+					}
 
-				if isAWSErr(err, "DependencyViolation", "") {
-					return resource.RetryableError(err)
-				}
-				if err != nil {
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
+					if len(sg.IpPermissionsEgress) > 0 {
+						req := &ec2.RevokeSecurityGroupEgressInput{
+							GroupId:       sg.GroupId,
+							IpPermissions: sg.IpPermissionsEgress,
+						}
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-			}
+						if _, err := conn.RevokeSecurityGroupEgress(req); err != nil {
+							return fmt.Errorf("error revoking egress rules: %s", err)
+						}
+					}
+
+					return nil
+				},
+				Delete: func() error {
+					_, err := conn.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+						GroupId: sg.GroupId,
+					})
+					return err
+				},
+			})
 		}
 
 		return !lastPage
 	})
 
-	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Security Groups: %s", err)
-	}
-
-	return nil
+	return resources, err
 }
 
 func TestProtocolStateFunc(t *testing.T) {
@@ -574,6 +549,161 @@ func TestResourceAwsSecurityGroupIPPermGather(t *testing.T) {
 	}
 }
 
+const testMockAwsSecurityGroupResponse = `
+<DescribeSecurityGroupsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <securityGroupInfo>
+    <item>
+      <ownerId>123456789012</ownerId>
+      <groupId>sg-12345678</groupId>
+      <groupName>tf-mock</groupName>
+      <groupDescription>Managed by Terraform</groupDescription>
+      <vpcId>vpc-12345678</vpcId>
+      <ipPermissions/>
+      <ipPermissionsEgress/>
+    </item>
+  </securityGroupInfo>
+</DescribeSecurityGroupsResponse>`
+
+func TestResourceAwsSecurityGroupCreate_eventualConsistency(t *testing.T) {
+	m := newTestMockAws(t, "ec2")
+	defer m.Close(t)
+
+	m.On("ec2", "CreateSecurityGroup").Respond(`<CreateSecurityGroupResponse><return>true</return><groupId>sg-12345678</groupId></CreateSecurityGroupResponse>`)
+	// The new group is not visible immediately
+	m.On("ec2", "DescribeSecurityGroups").
+		RespondError(http.StatusBadRequest, "InvalidGroup.NotFound", "The security group 'sg-12345678' does not exist").Times(2).
+		Respond(testMockAwsSecurityGroupResponse)
+	m.On("ec2", "RevokeSecurityGroupEgress").WhenParam("IpPermissions.1.Ipv6Ranges.1.CidrIpv6", "::/0").
+		RespondError(http.StatusBadRequest, "InvalidPermission.NotFound", "The specified rule does not exist in this security group.")
+	m.On("ec2", "RevokeSecurityGroupEgress").Respond(`<RevokeSecurityGroupEgressResponse><return>true</return></RevokeSecurityGroupEgressResponse>`)
+
+	d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{
+		"name":   "tf-mock",
+		"vpc_id": "vpc-12345678",
+	})
+	d.MarkNewResource()
+
+	if err := resourceAwsSecurityGroupCreate(d, m.client); err != nil {
+		t.Fatalf("error creating Security Group: %s", err)
+	}
+
+	if d.Id() != "sg-12345678" {
+		t.Fatalf("expected ID sg-12345678, got %q", d.Id())
+	}
+
+	if got, want := d.Get("arn").(string), "arn:aws:ec2:us-west-2:123456789012:security-group/sg-12345678"; got != want {
+		t.Fatalf("expected ARN %q, got %q", want, got)
+	}
+
+	if got := len(m.Requests("ec2", "RevokeSecurityGroupEgress")); got != 2 {
+		t.Fatalf("expected default egress rules to be revoked with 2 requests, got %d", got)
+	}
+
+	if got := len(m.Requests("ec2", "DescribeSecurityGroups")); got < 3 {
+		t.Fatalf("expected Security Group to be described at least 3 times, got %d", got)
+	}
+}
+
+func TestResourceAwsSecurityGroupCreate_error(t *testing.T) {
+	m := newTestMockAws(t, "ec2")
+	defer m.Close(t)
+
+	m.On("ec2", "CreateSecurityGroup").RespondError(http.StatusBadRequest, "InvalidGroup.Duplicate", "The security group 'tf-mock' already exists for VPC 'vpc-12345678'")
+
+	d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{
+		"name":   "tf-mock",
+		"vpc_id": "vpc-12345678",
+	})
+
+	err := resourceAwsSecurityGroupCreate(d, m.client)
+
+	if err == nil || !strings.Contains(err.Error(), "InvalidGroup.Duplicate") {
+		t.Fatalf("expected InvalidGroup.Duplicate error, got: %v", err)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("expected no ID, got %q", d.Id())
+	}
+}
+
+func TestResourceAwsSecurityGroupRead_throttling(t *testing.T) {
+	m := newTestMockAws(t, "ec2")
+	defer m.Close(t)
+
+	m.On("ec2", "DescribeSecurityGroups").
+		Throttle().Times(2).
+		Respond(testMockAwsSecurityGroupResponse)
+
+	d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{})
+	d.SetId("sg-12345678")
+
+	if err := resourceAwsSecurityGroupRead(d, m.client); err != nil {
+		t.Fatalf("error reading Security Group: %s", err)
+	}
+
+	if got := d.Get("name").(string); got != "tf-mock" {
+		t.Fatalf("expected name tf-mock, got %q", got)
+	}
+
+	if got := len(m.Requests("ec2", "DescribeSecurityGroups")); got != 3 {
+		t.Fatalf("expected 3 DescribeSecurityGroups requests, got %d", got)
+	}
+}
+
+func TestResourceAwsSecurityGroupRead_notFound(t *testing.T) {
+	m := newTestMockAws(t, "ec2")
+	defer m.Close(t)
+
+	m.On("ec2", "DescribeSecurityGroups").RespondError(http.StatusBadRequest, "InvalidGroup.NotFound", "The security group 'sg-12345678' does not exist")
+
+	d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{})
+	d.SetId("sg-12345678")
+
+	if err := resourceAwsSecurityGroupRead(d, m.client); err != nil {
+		t.Fatalf("error reading Security Group: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("expected Security Group to be removed from state, got ID %q", d.Id())
+	}
+}
+
+func TestResourceAwsSecurityGroupDelete_dependencyViolation(t *testing.T) {
+	m := newTestMockAws(t, "ec2")
+	defer m.Close(t)
+
+	m.On("ec2", "DescribeNetworkInterfaces").Respond(`<DescribeNetworkInterfacesResponse><networkInterfaceSet/></DescribeNetworkInterfacesResponse>`)
+	m.On("ec2", "DeleteSecurityGroup").
+		RespondError(http.StatusBadRequest, "DependencyViolation", "resource sg-12345678 has a dependent object").Times(2).
+		Respond(`<DeleteSecurityGroupResponse><return>true</return></DeleteSecurityGroupResponse>`)
+
+	d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{})
+	d.SetId("sg-12345678")
+
+	if err := resourceAwsSecurityGroupDelete(d, m.client); err != nil {
+		t.Fatalf("error deleting Security Group: %s", err)
+	}
+
+	if got := len(m.Requests("ec2", "DeleteSecurityGroup")); got != 3 {
+		t.Fatalf("expected 3 DeleteSecurityGroup requests, got %d", got)
+	}
+}
+
+func TestResourceAwsSecurityGroupDelete_notFound(t *testing.T) {
+	m := newTestMockAws(t, "ec2")
+	defer m.Close(t)
+
+	m.On("ec2", "DescribeNetworkInterfaces").Respond(`<DescribeNetworkInterfacesResponse><networkInterfaceSet/></DescribeNetworkInterfacesResponse>`)
+	m.On("ec2", "DeleteSecurityGroup").RespondError(http.StatusBadRequest, "InvalidGroup.NotFound", "The security group 'sg-12345678' does not exist")
+
+	d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{})
+	d.SetId("sg-12345678")
+
+	if err := resourceAwsSecurityGroupDelete(d, m.client); err != nil {
+		t.Fatalf("error deleting Security Group: %s", err)
+	}
+}
+
 func TestAccAWSSecurityGroup_importBasic(t *testing.T) {
 	checkFn := func(s []*terraform.InstanceState) error {
 		// Expect 2: group, 2 rules
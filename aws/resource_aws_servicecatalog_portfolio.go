@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsServiceCatalogPortfolio() *schema.Resource {
@@ -23,6 +24,7 @@ func resourceAwsServiceCatalogPortfolio() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -55,7 +57,8 @@ func resourceAwsServiceCatalogPortfolio() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 20),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -75,7 +78,7 @@ func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := []*servicecatalog.Tag{}
 		t := v.(map[string]interface{})
 		for k, v := range t {
@@ -123,11 +126,8 @@ func resourceAwsServiceCatalogPortfolioRead(d *schema.ResourceData, meta interfa
 	d.Set("description", portfolioDetail.Description)
 	d.Set("name", portfolioDetail.DisplayName)
 	d.Set("provider_name", portfolioDetail.ProviderName)
-	tags := map[string]string{}
-	for _, tag := range resp.Tags {
-		tags[*tag.Key] = *tag.Value
-	}
-	d.Set("tags", tags)
+	tags := keyvaluetags.ServicecatalogKeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig).Map()
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags)
 	return nil
 }
 
@@ -158,8 +158,8 @@ func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if d.HasChange("tags") {
-		currentTags, requiredTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		currentTags, requiredTags := d.GetChange("tags_all")
 		log.Printf("[DEBUG] Current Tags: %#v", currentTags)
 		log.Printf("[DEBUG] Required Tags: %#v", requiredTags)
 
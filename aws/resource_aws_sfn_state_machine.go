@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsSfnStateMachine() *schema.Resource {
@@ -22,6 +23,7 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"definition": {
@@ -52,7 +54,8 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -65,7 +68,7 @@ func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{})
 		Definition: aws.String(d.Get("definition").(string)),
 		Name:       aws.String(d.Get("name").(string)),
 		RoleArn:    aws.String(d.Get("role_arn").(string)),
-		Tags:       tagsFromMapSfn(d.Get("tags").(map[string]interface{})),
+		Tags:       tagsFromMapSfn(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	var activity *sfn.CreateStateMachineOutput
@@ -140,10 +143,10 @@ func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if tagsResp != nil {
-		tags = tagsToMapSfn(tagsResp.Tags)
+		tags = tagsToMapSfn(tagsResp.Tags, meta.(*AWSClient).ignoreTagsConfig)
 	}
 
-	if err := d.Set("tags", tags); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -170,39 +173,11 @@ func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
-		oldTagsMap := oldTagsRaw.(map[string]interface{})
-		newTagsMap := newTagsRaw.(map[string]interface{})
-		createTags, removeTags := diffTagsSfn(tagsFromMapSfn(oldTagsMap), tagsFromMapSfn(newTagsMap))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if len(removeTags) > 0 {
-			removeTagKeys := make([]*string, len(removeTags))
-			for i, removeTag := range removeTags {
-				removeTagKeys[i] = removeTag.Key
-			}
-
-			input := &sfn.UntagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				TagKeys:     removeTagKeys,
-			}
-
-			log.Printf("[DEBUG] Untagging State Function: %s", input)
-			if _, err := conn.UntagResource(input); err != nil {
-				return fmt.Errorf("error untagging State Function (%s): %s", d.Id(), err)
-			}
-		}
-
-		if len(createTags) > 0 {
-			input := &sfn.TagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				Tags:        createTags,
-			}
-
-			log.Printf("[DEBUG] Tagging State Function: %s", input)
-			if _, err := conn.TagResource(input); err != nil {
-				return fmt.Errorf("error tagging State Function (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.SfnUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating State Function (%s) tags: %s", d.Id(), err)
 		}
 	}
 	return resourceAwsSfnStateMachineRead(d, meta)
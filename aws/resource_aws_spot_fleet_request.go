@@ -1028,7 +1028,7 @@ func launchSpecToMap(l *ec2.SpotFleetLaunchSpecification, rootDevName *string) m
 		for _, tagSpecs := range l.TagSpecifications {
 			// only "instance" tags are currently supported: http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotFleetTagSpecification.html
 			if *(tagSpecs.ResourceType) == "instance" {
-				m["tags"] = tagsToMap(tagSpecs.Tags)
+				m["tags"] = tagsToMap(tagSpecs.Tags, nil)
 			}
 		}
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 var sqsQueueAttributeMap = map[string]string{
@@ -44,6 +45,7 @@ func resourceAwsSqsQueue() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -125,7 +127,8 @@ func resourceAwsSqsQueue() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -408,9 +411,9 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	} else {
-		tags = tagsToMapGeneric(listTagsOutput.Tags)
+		tags = tagsToMapGeneric(listTagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig)
 	}
-	d.Set("tags", tags)
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tags)
 
 	return nil
 }
@@ -441,36 +444,10 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 }
 
 func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
-
-			_, err := conn.UntagQueue(&sqs.UntagQueueInput{
-				QueueUrl: aws.String(d.Id()),
-				TagKeys:  keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.TagQueue(&sqs.TagQueueInput{
-				QueueUrl: aws.String(d.Id()),
-				Tags:     create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.SqsUpdateTags(conn, d.Id(), o, n)
 	}
 
 	return nil
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -17,6 +18,13 @@ func resourceAwsSsmActivation() *schema.Resource {
 		Create: resourceAwsSsmActivationCreate,
 		Read:   resourceAwsSsmActivationRead,
 		Delete: resourceAwsSsmActivationDelete,
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			// Default tag changes also require a new resource as tags cannot be updated
+			customdiff.ForceNewIfChange("tags_all", func(old, new, meta interface{}) bool {
+				return true
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -57,7 +65,8 @@ func resourceAwsSsmActivation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchemaForceNew(),
+			"tags":     tagsSchemaForceNew(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -91,7 +100,7 @@ func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) er
 	if _, ok := d.GetOk("registration_limit"); ok {
 		activationInput.RegistrationLimit = aws.Int64(int64(d.Get("registration_limit").(int)))
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		activationInput.Tags = tagsFromMapSSM(v.(map[string]interface{}))
 	}
 
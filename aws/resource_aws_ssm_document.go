@@ -27,6 +27,7 @@ func resourceAwsSsmDocument() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -142,7 +143,8 @@ func resourceAwsSsmDocument() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -159,7 +161,7 @@ func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) erro
 		DocumentType:   aws.String(d.Get("document_type").(string)),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		docInput.Tags = tagsFromMapSSM(v.(map[string]interface{}))
 	}
 
@@ -309,7 +311,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error listing SSM Document tags for %s: %s", d.Id(), err)
 	}
-	d.Set("tags", tagsToMapSSM(tagList.TagList))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapSSM(tagList.TagList, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -317,7 +319,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsSSM(ssmconn, d, d.Id(), ssm.ResourceTypeForTaggingDocument); err != nil {
 			return fmt.Errorf("error setting SSM Document tags: %s", err)
 		}
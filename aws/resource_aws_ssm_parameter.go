@@ -79,10 +79,12 @@ func resourceAwsSsmParameter() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
 		CustomizeDiff: customdiff.All(
+			setTagsDiff,
 			// Prevent the following error during tier update from Advanced to Standard:
 			// ValidationException: This parameter uses the advanced-parameter tier. You can't downgrade a parameter from the advanced-parameter tier to the standard-parameter tier. If necessary, you can delete the advanced parameter and recreate it as a standard parameter.
 			customdiff.ForceNewIfChange("tier", func(old, new, meta interface{}) bool {
@@ -162,7 +164,7 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	}); err != nil {
 		return fmt.Errorf("Failed to get SSM parameter tags for %s: %s", d.Get("name"), err)
 	} else {
-		d.Set("tags", tagsToMapSSM(tagList.TagList))
+		setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapSSM(tagList.TagList, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	arn := arn.ARN{
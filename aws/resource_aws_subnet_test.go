@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"testing"
 	"time"
@@ -12,13 +11,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // add sweeper to delete known test subnets
 func init() {
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
-		Name: "aws_subnet",
-		F:    testSweepSubnets,
+	addTestSweeper(&testSweeper{
+		Name:        "aws_subnet",
+		Description: "EC2 Subnet",
 		Dependencies: []string{
 			"aws_autoscaling_group",
 			"aws_batch_compute_environment",
@@ -43,65 +43,47 @@ func init() {
 			"aws_spot_fleet_request",
 			"aws_vpc_endpoint",
 		},
+		List: testSweepSubnets,
+		// Handle eventual consistency, especially with lingering ENIs from Load Balancers and Lambda
+		RetryableErrorCodes: []string{"DependencyViolation"},
+		RetryTimeout:        5 * time.Minute,
 	})
 }
 
-func testSweepSubnets(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).ec2conn
-
-	req := &ec2.DescribeSubnetsInput{}
-	resp, err := conn.DescribeSubnets(req)
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 Subnet sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing subnets: %s", err)
-	}
-
-	if len(resp.Subnets) == 0 {
-		log.Print("[DEBUG] No aws subnets to sweep")
-		return nil
-	}
-
-	for _, subnet := range resp.Subnets {
-		if subnet == nil {
-			continue
-		}
-
-		if aws.BoolValue(subnet.DefaultForAz) {
-			continue
-		}
-
-		input := &ec2.DeleteSubnetInput{
-			SubnetId: subnet.SubnetId,
-		}
+func testSweepSubnets(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.ec2conn
+	var resources []*testSweepResource
 
-		// Handle eventual consistency, especially with lingering ENIs from Load Balancers and Lambda
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteSubnet(input)
-
-			if isAWSErr(err, "DependencyViolation", "") {
-				return resource.RetryableError(err)
+	err := conn.DescribeSubnetsPages(&ec2.DescribeSubnetsInput{}, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		for _, subnet := range page.Subnets {
+			if subnet == nil {
+				continue
 			}
 
-			if err != nil {
-				return resource.NonRetryableError(err)
+			if aws.BoolValue(subnet.DefaultForAz) {
+				continue
 			}
 
-			return nil
-		})
-
-		if err != nil {
-			return fmt.Errorf("Error deleting Subnet (%s): %s", aws.StringValue(subnet.SubnetId), err)
+			input := &ec2.DeleteSubnetInput{
+				SubnetId: subnet.SubnetId,
+			}
+			tags := keyvaluetags.Ec2KeyValueTags(subnet.Tags).Map()
+
+			resources = append(resources, &testSweepResource{
+				ID:   aws.StringValue(subnet.SubnetId),
+				Name: tags["Name"],
+				Tags: tags,
+				Delete: func() error {
+					_, err := conn.DeleteSubnet(input)
+					return err
+				},
+			})
 		}
-	}
 
-	return nil
+		return !lastPage
+	})
+
+	return resources, err
 }
 
 func TestAccAWSSubnet_importBasic(t *testing.T) {
@@ -24,6 +24,7 @@ func resourceAwsTransferUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 		Schema: map[string]*schema.Schema{
 
 			"arn": {
@@ -57,7 +58,8 @@ func resourceAwsTransferUser() *schema.Resource {
 				ValidateFunc: validateTransferServerID,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"user_name": {
 				Type:         schema.TypeString,
@@ -88,7 +90,7 @@ func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) err
 		createOpts.Policy = aws.String(attr.(string))
 	}
 
-	if attr, ok := d.GetOk("tags"); ok {
+	if attr, ok := d.GetOk("tags_all"); ok {
 		createOpts.Tags = tagsFromMapTransfer(attr.(map[string]interface{}))
 	}
 
@@ -135,7 +137,7 @@ func resourceAwsTransferUserRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("policy", resp.User.Policy)
 	d.Set("role", resp.User.Role)
 
-	if err := d.Set("tags", tagsToMapTransfer(resp.User.Tags)); err != nil {
+	if err := setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMapTransfer(resp.User.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("Error setting tags: %s", err)
 	}
 	return nil
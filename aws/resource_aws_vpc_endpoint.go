@@ -23,6 +23,7 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"auto_accept": {
@@ -114,7 +115,8 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"vpc_endpoint_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -271,7 +273,7 @@ func resourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error setting subnet_ids: %s", err)
 	}
-	err = d.Set("tags", tagsToMap(vpce.Tags))
+	err = setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(vpce.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	if err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
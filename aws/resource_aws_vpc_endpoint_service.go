@@ -21,6 +21,7 @@ func resourceAwsVpcEndpointService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"acceptance_required": {
@@ -73,7 +74,8 @@ func resourceAwsVpcEndpointService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -139,7 +141,7 @@ func resourceAwsVpcEndpointServiceRead(d *schema.ResourceData, meta interface{})
 	d.Set("service_name", svcCfg.ServiceName)
 	d.Set("service_type", svcCfg.ServiceType[0].ServiceType)
 	d.Set("state", svcCfg.ServiceState)
-	err = d.Set("tags", tagsToMap(svcCfg.Tags))
+	err = setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(svcCfg.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	if err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
@@ -206,6 +208,7 @@ func resourceAwsVpcEndpointServiceUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsVpcEndpointServiceRead(d, meta)
@@ -21,6 +21,7 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -62,6 +63,7 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 			"accepter":  vpcPeeringConnectionOptionsSchema(),
 			"requester": vpcPeeringConnectionOptionsSchema(),
 			"tags":      tagsSchema(),
+			"tags_all":  tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -171,7 +173,7 @@ func resourceAwsVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	err = d.Set("tags", tagsToMap(pc.Tags))
+	err = setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(pc.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	if err != nil {
 		return fmt.Errorf("Error setting VPC Peering Connection tags: %s", err)
 	}
@@ -225,6 +227,7 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	pcRaw, _, err := vpcPeeringConnectionRefreshState(conn, d.Id())()
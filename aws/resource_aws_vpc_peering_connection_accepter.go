@@ -10,10 +10,11 @@ import (
 
 func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsVPCPeeringAccepterCreate,
-		Read:   resourceAwsVPCPeeringRead,
-		Update: resourceAwsVPCPeeringUpdate,
-		Delete: resourceAwsVPCPeeringAccepterDelete,
+		Create:        resourceAwsVPCPeeringAccepterCreate,
+		Read:          resourceAwsVPCPeeringRead,
+		Update:        resourceAwsVPCPeeringUpdate,
+		Delete:        resourceAwsVPCPeeringAccepterDelete,
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_peering_connection_id": {
@@ -48,6 +49,7 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 			"accepter":  vpcPeeringConnectionOptionsSchema(),
 			"requester": vpcPeeringConnectionOptionsSchema(),
 			"tags":      tagsSchema(),
+			"tags_all":  tagsSchemaTrulyComputed(),
 		},
 	}
 }
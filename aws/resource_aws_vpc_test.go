@@ -5,19 +5,20 @@ import (
 	"log"
 	"regexp"
 	"testing"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // add sweeper to delete known test vpcs
 func init() {
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
-		Name: "aws_vpc",
+	addTestSweeper(&testSweeper{
+		Name:        "aws_vpc",
+		Description: "EC2 VPC",
 		Dependencies: []string{
 			"aws_internet_gateway",
 			"aws_nat_gateway",
@@ -27,61 +28,47 @@ func init() {
 			"aws_subnet",
 			"aws_vpn_gateway",
 		},
-		F: testSweepVPCs,
+		List: testSweepVPCs,
+		// Handle EC2 eventual consistency
+		RetryableErrorCodes: []string{"DependencyViolation"},
 	})
 }
 
-func testSweepVPCs(region string) error {
-	client, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*AWSClient).ec2conn
+func testSweepVPCs(client *AWSClient) ([]*testSweepResource, error) {
+	conn := client.ec2conn
+	var resources []*testSweepResource
 
-	req := &ec2.DescribeVpcsInput{}
-	resp, err := conn.DescribeVpcs(req)
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 VPC sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing vpcs: %s", err)
-	}
-
-	if len(resp.Vpcs) == 0 {
-		log.Print("[DEBUG] No aws vpcs to sweep")
-		return nil
-	}
-
-	for _, vpc := range resp.Vpcs {
-		if aws.BoolValue(vpc.IsDefault) {
-			log.Printf("[DEBUG] Skipping Default VPC: %s", aws.StringValue(vpc.VpcId))
-			continue
-		}
-
-		input := &ec2.DeleteVpcInput{
-			VpcId: vpc.VpcId,
-		}
-		log.Printf("[DEBUG] Deleting VPC: %s", input)
-
-		// Handle EC2 eventual consistency
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteVpc(input)
-			if isAWSErr(err, "DependencyViolation", "") {
-				return resource.RetryableError(err)
+	err := conn.DescribeVpcsPages(&ec2.DescribeVpcsInput{}, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		for _, vpc := range page.Vpcs {
+			if vpc == nil {
+				continue
 			}
-			if err != nil {
-				return resource.NonRetryableError(err)
+
+			if aws.BoolValue(vpc.IsDefault) {
+				log.Printf("[DEBUG] Skipping Default VPC: %s", aws.StringValue(vpc.VpcId))
+				continue
 			}
-			return nil
-		})
 
-		if err != nil {
-			return fmt.Errorf("Error deleting VPC (%s): %s", aws.StringValue(vpc.VpcId), err)
+			input := &ec2.DeleteVpcInput{
+				VpcId: vpc.VpcId,
+			}
+			tags := keyvaluetags.Ec2KeyValueTags(vpc.Tags).Map()
+
+			resources = append(resources, &testSweepResource{
+				ID:   aws.StringValue(vpc.VpcId),
+				Name: tags["Name"],
+				Tags: tags,
+				Delete: func() error {
+					_, err := conn.DeleteVpc(input)
+					return err
+				},
+			})
 		}
-	}
 
-	return nil
+		return !lastPage
+	})
+
+	return resources, err
 }
 
 func TestAccAWSVpc_basic(t *testing.T) {
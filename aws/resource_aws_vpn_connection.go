@@ -69,6 +69,7 @@ func resourceAwsVpnConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
@@ -143,7 +144,8 @@ func resourceAwsVpnConnection() *schema.Resource {
 				ValidateFunc: validateVpnConnectionTunnelPreSharedKey,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			// Begin read only attributes
 			"customer_gateway_configuration": {
@@ -449,7 +451,7 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("customer_gateway_id", vpnConnection.CustomerGatewayId)
 	d.Set("transit_gateway_id", vpnConnection.TransitGatewayId)
 	d.Set("type", vpnConnection.Type)
-	d.Set("tags", tagsToMap(vpnConnection.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(vpnConnection.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	if vpnConnection.Options != nil {
 		if err := d.Set("static_routes_only", vpnConnection.Options.StaticRoutesOnly); err != nil {
@@ -503,6 +505,8 @@ func resourceAwsVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetPartial("tags")
 
+	d.SetPartial("tags_all")
+
 	return resourceAwsVpnConnectionRead(d, meta)
 }
 
@@ -22,6 +22,7 @@ func resourceAwsVpnGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -44,7 +45,8 @@ func resourceAwsVpnGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -115,7 +117,7 @@ func resourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("availability_zone", vpnGateway.AvailabilityZone)
 	}
 	d.Set("amazon_side_asn", strconv.FormatInt(aws.Int64Value(vpnGateway.AmazonSideAsn), 10))
-	d.Set("tags", tagsToMap(vpnGateway.Tags))
+	setTagsAndTagsAll(d, meta.(*AWSClient).defaultTagsConfig, tagsToMap(vpnGateway.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -141,6 +143,8 @@ func resourceAwsVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetPartial("tags")
 
+	d.SetPartial("tags_all")
+
 	return resourceAwsVpnGatewayRead(d, meta)
 }
 
@@ -13,8 +13,8 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
@@ -52,7 +52,7 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func getTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
+func getTagsS3Object(conn *s3.S3, d *schema.ResourceData, ignoreConfig *IgnoreTagsConfig, defaultTagsConfig *DefaultTagsConfig) error {
 	resp, err := retryOnAwsCode(s3.ErrCodeNoSuchKey, func() (interface{}, error) {
 		return conn.GetObjectTagging(&s3.GetObjectTaggingInput{
 			Bucket: aws.String(d.Get("bucket").(string)),
@@ -63,7 +63,7 @@ func getTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapS3(resp.(*s3.GetObjectTaggingOutput).TagSet)); err != nil {
+	if err := setTagsAndTagsAll(d, defaultTagsConfig, tagsToMapS3(resp.(*s3.GetObjectTaggingOutput).TagSet, ignoreConfig)); err != nil {
 		return err
 	}
 
@@ -71,8 +71,8 @@ func getTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 }
 
 func setTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 
@@ -143,10 +143,10 @@ func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapS3(ts []*s3.Tag) map[string]string {
+func tagsToMapS3(ts []*s3.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredS3(t) {
+		if !tagIgnoredS3(t) && !ignoreConfig.Ignored(*t.Key) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -48,8 +48,8 @@ func TestDiffTagsS3(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsS3(tagsFromMapS3(tc.Old), tagsFromMapS3(tc.New))
-		cm := tagsToMapS3(c)
-		rm := tagsToMapS3(r)
+		cm := tagsToMapS3(c, nil)
+		rm := tagsToMapS3(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
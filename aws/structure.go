@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/appmesh"
@@ -1633,14 +1634,6 @@ func expandCloudFormationTags(tags map[string]interface{}) []*cloudformation.Tag
 	return cfTags
 }
 
-func flattenCloudFormationTags(cfTags []*cloudformation.Tag) map[string]string {
-	tags := make(map[string]string, len(cfTags))
-	for _, t := range cfTags {
-		tags[*t.Key] = *t.Value
-	}
-	return tags
-}
-
 func flattenCloudFormationOutputs(cfOutputs []*cloudformation.Output) map[string]string {
 	outputs := make(map[string]string, len(cfOutputs))
 	for _, o := range cfOutputs {
@@ -2414,6 +2407,17 @@ func buildApiGatewayInvokeURL(restApiId, region, stageName string) string {
 		restApiId, region, stageName)
 }
 
+// buildApiGatewayInvokeHostname returns the hostname of an API's execute-api
+// endpoint, using the DNS suffix of the region's partition.
+func buildApiGatewayInvokeHostname(apiId, region string) string {
+	dnsSuffix := "amazonaws.com"
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && partition.ID() == endpoints.AwsCnPartitionID {
+		dnsSuffix = "amazonaws.com.cn"
+	}
+
+	return fmt.Sprintf("%s.execute-api.%s.%s", apiId, region, dnsSuffix)
+}
+
 func expandCognitoSupportedLoginProviders(config map[string]interface{}) map[string]*string {
 	m := map[string]*string{}
 	for k, v := range config {
@@ -4373,6 +4377,7 @@ func flattenAwsDynamoDbTableResource(d *schema.ResourceData, table *dynamodb.Tab
 	if table.SSEDescription != nil {
 		m := map[string]interface{}{}
 		m["enabled"] = aws.StringValue(table.SSEDescription.Status) == dynamodb.SSEStatusEnabled
+		m["kms_key_arn"] = aws.StringValue(table.SSEDescription.KMSMasterKeyArn)
 		sseOptions = []map[string]interface{}{m}
 	}
 	err = d.Set("server_side_encryption", sseOptions)
@@ -4500,8 +4505,15 @@ func expandDynamoDbKeySchema(data map[string]interface{}) []*dynamodb.KeySchemaE
 func expandDynamoDbEncryptAtRestOptions(m map[string]interface{}) *dynamodb.SSESpecification {
 	options := dynamodb.SSESpecification{}
 
+	enabled := false
 	if v, ok := m["enabled"]; ok {
-		options.Enabled = aws.Bool(v.(bool))
+		enabled = v.(bool)
+		options.Enabled = aws.Bool(enabled)
+	}
+
+	if v, ok := m["kms_key_arn"].(string); ok && v != "" && enabled {
+		options.KMSMasterKeyId = aws.String(v)
+		options.SSEType = aws.String(dynamodb.SSETypeKms)
 	}
 
 	return &options
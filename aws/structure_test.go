@@ -1337,6 +1337,32 @@ func TestCognitoUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 	}
 }
 
+func TestBuildApiGatewayInvokeHostname(t *testing.T) {
+	cases := []struct {
+		Region   string
+		Expected string
+	}{
+		{
+			Region:   "us-west-2",
+			Expected: "abc123.execute-api.us-west-2.amazonaws.com",
+		},
+		{
+			Region:   "us-gov-west-1",
+			Expected: "abc123.execute-api.us-gov-west-1.amazonaws.com",
+		},
+		{
+			Region:   "cn-north-1",
+			Expected: "abc123.execute-api.cn-north-1.amazonaws.com.cn",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := buildApiGatewayInvokeHostname("abc123", tc.Region); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tc.Region, tc.Expected, got)
+		}
+	}
+}
+
 func TestCanonicalXML(t *testing.T) {
 	cases := []struct {
 		Name        string
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // tagsSchema returns the schema to use for tags.
@@ -41,38 +41,91 @@ func tagsSchemaForceNew() *schema.Schema {
 	}
 }
 
-func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
+// tagsSchemaTrulyComputed returns the schema to use for the tags_all attribute,
+// which contains the resource tags merged with the provider default tags.
+func tagsSchemaTrulyComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
-			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
-			})
-			if err != nil {
-				return err
-			}
+// DefaultTagsConfig contains the provider-level default tags which are merged
+// into the tags of every resource supporting the tags_all attribute.
+type DefaultTagsConfig struct {
+	Tags map[string]string
+}
+
+// MergeTags returns the default tags merged with the given resource tags.
+// Resource tags take precedence over default tags with the same key.
+// A nil configuration returns the resource tags unchanged.
+func (c *DefaultTagsConfig) MergeTags(tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	if c != nil {
+		for k, v := range c.Tags {
+			result[k] = v
 		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.AddTags(&elbv2.AddTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
-			})
-			if err != nil {
-				return err
+	}
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// setTagsDiff is a CustomizeDiff function which plans the tags_all attribute
+// from the resource tags and the provider default tags.
+func setTagsDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	defaultTagsConfig := meta.(*AWSClient).defaultTagsConfig
+	allTags := defaultTagsConfig.MergeTags(diff.Get("tags").(map[string]interface{}))
+
+	return diff.SetNew("tags_all", allTags)
+}
+
+// setTagsAndTagsAll sets the tags_all attribute to all tags read from the
+// resource and the tags attribute to those tags without the provider default
+// tags. Default tags which are also configured on the resource are kept.
+func setTagsAndTagsAll(d *schema.ResourceData, defaultTagsConfig *DefaultTagsConfig, allTags map[string]string) error {
+	configuredTags := d.Get("tags").(map[string]interface{})
+	tags := make(map[string]string)
+
+	for k, v := range allTags {
+		if defaultTagsConfig != nil {
+			if defaultValue, ok := defaultTagsConfig.Tags[k]; ok && defaultValue == v {
+				if _, ok := configuredTags[k]; !ok {
+					continue
+				}
 			}
 		}
+
+		tags[k] = v
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return err
+	}
+
+	return d.Set("tags_all", allTags)
+}
+
+// IgnoreTagsConfig contains the provider-level configuration for tags that
+// should be ignored across all resources, in addition to the AWS reserved
+// "aws:" prefix. Ignored tags are not read into the Terraform state, so they
+// are never removed when updating a resource's tags.
+type IgnoreTagsConfig = keyvaluetags.IgnoreConfig
+
+func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return keyvaluetags.Elbv2UpdateTags(conn, d.Id(), o, n)
 	}
 
 	return nil
@@ -83,7 +136,10 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		oraw, nraw := d.GetChange("volume_tags")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		create := oldTags.Updated(newTags).IgnoreAws().Ec2Tags()
+		remove := oldTags.Removed(newTags).IgnoreAws().Ec2Tags()
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 		if err != nil {
@@ -160,13 +216,16 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 }
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// tags_all field to be named "tags_all"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		create := oldTags.Updated(newTags).IgnoreAws().Ec2Tags()
+		remove := oldTags.Removed(newTags).IgnoreAws().Ec2Tags()
 
 		// Set tags
 		if len(remove) > 0 {
@@ -238,134 +297,24 @@ func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
-
-	return tagsFromMap(create), remove
-}
-
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().Ec2Tags()
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
-}
-
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapELBv2(create), remove
+func tagsToMap(ts []*ec2.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
+	return keyvaluetags.Ec2KeyValueTags(ts).IgnoreAws().IgnoreConfig(ignoreConfig).Map()
 }
 
 // tagsToMapELBv2 turns the list of tags into a map.
-func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+func tagsToMapELBv2(ts []*elbv2.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
+	return keyvaluetags.Elbv2KeyValueTags(ts).IgnoreAws().IgnoreConfig(ignoreConfig).Map()
 }
 
 // tagsFromMapELBv2 returns the tags for the given map of data.
 func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
-}
-
-// tagIgnored compares a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
-
-// and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return keyvaluetags.New(m).IgnoreAws().Elbv2Tags()
 }
 
 // tagsMapToHash returns a stable hash value for a raw tags map.
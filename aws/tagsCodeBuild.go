@@ -1,27 +1,14 @@
 package aws
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
-	result := []*codebuild.Tag{}
-	for k, v := range m {
-		result = append(result, &codebuild.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().CodebuildTags()
 }
 
-func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
-	result := map[string]string{}
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
-	}
-
-	return result
+func tagsToMapCodeBuild(ts []*codebuild.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
+	return keyvaluetags.CodebuildKeyValueTags(ts).IgnoreAws().IgnoreConfig(ignoreConfig).Map()
 }
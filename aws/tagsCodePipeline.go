@@ -2,81 +2,26 @@ package aws
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// tags_all field to be named "tags_all"
 func setTagsCodePipeline(conn *codepipeline.CodePipeline, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCodePipeline(tagsFromMapCodePipeline(o), tagsFromMapCodePipeline(n))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
-
-			_, err := conn.UntagResource(&codepipeline.UntagResourceInput{
-				ResourceArn: aws.String(d.Get("arn").(string)),
-				TagKeys:     k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&codepipeline.TagResourceInput{
-				ResourceArn: aws.String(d.Get("arn").(string)),
-				Tags:        create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.CodepipelineUpdateTags(conn, d.Get("arn").(string), o, n)
 	}
 
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsCodePipeline(oldTags, newTags []*codepipeline.Tag) ([]*codepipeline.Tag, []*codepipeline.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*codepipeline.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
-
-	return tagsFromMapCodePipeline(create), remove
-}
-
-func saveTagsCodePipeline(conn *codepipeline.CodePipeline, d *schema.ResourceData) error {
+func saveTagsCodePipeline(conn *codepipeline.CodePipeline, d *schema.ResourceData, ignoreConfig *IgnoreTagsConfig, defaultTagsConfig *DefaultTagsConfig) error {
 	resp, err := conn.ListTagsForResource(&codepipeline.ListTagsForResourceInput{
 		ResourceArn: aws.String(d.Get("arn").(string)),
 	})
@@ -90,48 +35,15 @@ func saveTagsCodePipeline(conn *codepipeline.CodePipeline, d *schema.ResourceDat
 		dt = resp.Tags
 	}
 
-	return d.Set("tags", tagsToMapCodePipeline(dt))
+	return setTagsAndTagsAll(d, defaultTagsConfig, tagsToMapCodePipeline(dt, ignoreConfig))
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapCodePipeline(m map[string]interface{}) []*codepipeline.Tag {
-	result := make([]*codepipeline.Tag, 0, len(m))
-	for k, v := range m {
-		t := &codepipeline.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredCodePipeline(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().CodepipelineTags()
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapCodePipeline(ts []*codepipeline.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredCodePipeline(t) {
-			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-		}
-	}
-
-	return result
-}
-
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredCodePipeline(t *codepipeline.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, aws.StringValue(t.Key))
-		r, _ := regexp.MatchString(v, aws.StringValue(t.Key))
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", aws.StringValue(t.Key), aws.StringValue(t.Value))
-			return true
-		}
-	}
-	return false
+func tagsToMapCodePipeline(ts []*codepipeline.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
+	return keyvaluetags.CodepipelineKeyValueTags(ts).IgnoreAws().IgnoreConfig(ignoreConfig).Map()
 }
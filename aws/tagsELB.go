@@ -2,28 +2,27 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// tags_all field to be named "tags_all"
 func setTagsELB(conn *elb.ELB, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsELB(tagsFromMapELB(o), tagsFromMapELB(n))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
 
 		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*elb.TagKeyOnly, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, &elb.TagKeyOnly{Key: t.Key})
+		if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+			log.Printf("[DEBUG] Removing tags: %s", removedTags.Keys())
+			k := make([]*elb.TagKeyOnly, 0, len(removedTags))
+			for _, key := range removedTags.Keys() {
+				k = append(k, &elb.TagKeyOnly{Key: aws.String(key)})
 			}
 			_, err := conn.RemoveTags(&elb.RemoveTagsInput{
 				LoadBalancerNames: []*string{aws.String(d.Get("name").(string))},
@@ -33,11 +32,11 @@ func setTagsELB(conn *elb.ELB, d *schema.ResourceData) error {
 				return err
 			}
 		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+		if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+			log.Printf("[DEBUG] Creating tags: %s", updatedTags.Keys())
 			_, err := conn.AddTags(&elb.AddTagsInput{
 				LoadBalancerNames: []*string{aws.String(d.Get("name").(string))},
-				Tags:              create,
+				Tags:              updatedTags.ElbTags(),
 			})
 			if err != nil {
 				return err
@@ -48,68 +47,12 @@ func setTagsELB(conn *elb.ELB, d *schema.ResourceData) error {
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsELB(oldTags, newTags []*elb.Tag) ([]*elb.Tag, []*elb.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*elb.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapELB(create), remove
-}
-
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapELB(m map[string]interface{}) []*elb.Tag {
-	var result []*elb.Tag
-	for k, v := range m {
-		t := &elb.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELB(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().ElbTags()
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapELB(ts []*elb.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredELB(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
-}
-
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredELB(t *elb.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagsToMapELB(ts []*elb.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
+	return keyvaluetags.ElbKeyValueTags(ts).IgnoreAws().IgnoreConfig(ignoreConfig).Map()
 }
@@ -1,40 +1,22 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapInspector(m map[string]interface{}) []*inspector.ResourceGroupTag {
-	var result []*inspector.ResourceGroupTag
-	for k, v := range m {
-		t := &inspector.ResourceGroupTag{
+	tags := keyvaluetags.New(m).IgnoreAws()
+	result := make([]*inspector.ResourceGroupTag, 0, len(tags))
+
+	for _, k := range tags.Keys() {
+		result = append(result, &inspector.ResourceGroupTag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredInspector(t) {
-			result = append(result, t)
-		}
+			Value: tags[k],
+		})
 	}
 
 	return result
 }
-
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredInspector(t *inspector.ResourceGroupTag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
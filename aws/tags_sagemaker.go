@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,40 +9,26 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func tagsFromMapSagemaker(m map[string]interface{}) []*sagemaker.Tag {
-	result := make([]*sagemaker.Tag, 0, len(m))
-	for k, v := range m {
-		t := &sagemaker.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredSagemaker(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().SagemakerTags()
 }
 
-func tagsToMapSagemaker(ts []*sagemaker.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredSagemaker(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+func tagsToMapSagemaker(ts []*sagemaker.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
+	return keyvaluetags.SagemakerKeyValueTags(ts).IgnoreAws().IgnoreConfig(ignoreConfig).Map()
 }
 
 func setSagemakerTags(conn *sagemaker.SageMaker, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffSagemakerTags(tagsFromMapSagemaker(o), tagsFromMapSagemaker(n))
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		create := oldTags.Updated(newTags).IgnoreAws().SagemakerTags()
+		remove := aws.StringSlice(oldTags.Removed(newTags).IgnoreAws().Keys())
 
 		if len(remove) > 0 {
 			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -89,32 +74,3 @@ func setSagemakerTags(conn *sagemaker.SageMaker, d *schema.ResourceData) error {
 
 	return nil
 }
-
-func diffSagemakerTags(oldTags, newTags []*sagemaker.Tag) ([]*sagemaker.Tag, []*string) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	var remove []*string
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t.Key)
-		}
-	}
-
-	return tagsFromMapSagemaker(create), remove
-}
-
-func tagIgnoredSagemaker(t *sagemaker.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
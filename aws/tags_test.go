@@ -8,107 +8,243 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
-func TestDiffTags(t *testing.T) {
-	cases := []struct {
-		Old, New       map[string]interface{}
-		Create, Remove map[string]string
+func TestIgnoreTagsConfigIgnored(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		IgnoreConfig *IgnoreTagsConfig
+		Key          string
+		Expected     bool
 	}{
-		// Add
 		{
-			Old: map[string]interface{}{
-				"foo": "bar",
+			Name:         "nil configuration",
+			IgnoreConfig: nil,
+			Key:          "key1",
+			Expected:     false,
+		},
+		{
+			Name:         "empty configuration",
+			IgnoreConfig: &IgnoreTagsConfig{},
+			Key:          "key1",
+			Expected:     false,
+		},
+		{
+			Name: "keys match",
+			IgnoreConfig: &IgnoreTagsConfig{
+				Keys: []string{"key1", "key2"},
 			},
-			New: map[string]interface{}{
-				"foo": "bar",
-				"bar": "baz",
+			Key:      "key2",
+			Expected: true,
+		},
+		{
+			Name: "keys no match",
+			IgnoreConfig: &IgnoreTagsConfig{
+				Keys: []string{"key1", "key2"},
 			},
-			Create: map[string]string{
-				"bar": "baz",
+			Key:      "key12",
+			Expected: false,
+		},
+		{
+			Name: "key prefixes match",
+			IgnoreConfig: &IgnoreTagsConfig{
+				KeyPrefixes: []string{"config:", "cost:"},
 			},
-			Remove: map[string]string{},
+			Key:      "cost:center",
+			Expected: true,
 		},
+		{
+			Name: "key prefixes no match",
+			IgnoreConfig: &IgnoreTagsConfig{
+				KeyPrefixes: []string{"config:", "cost:"},
+			},
+			Key:      "center:cost",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.IgnoreConfig.Ignored(testCase.Key)
 
-		// Modify
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTagsToMapIgnoreConfig(t *testing.T) {
+	tags := []*ec2.Tag{
 		{
-			Old: map[string]interface{}{
-				"foo": "bar",
+			Key:   aws.String("Name"),
+			Value: aws.String("test"),
+		},
+		{
+			Key:   aws.String("aws:cloudformation:stack-name"),
+			Value: aws.String("stack"),
+		},
+		{
+			Key:   aws.String("CostCenter"),
+			Value: aws.String("1234"),
+		},
+		{
+			Key:   aws.String("config:compliance"),
+			Value: aws.String("true"),
+		},
+	}
+	ignoreConfig := &IgnoreTagsConfig{
+		Keys:        []string{"CostCenter"},
+		KeyPrefixes: []string{"config:"},
+	}
+
+	expected := map[string]string{
+		"Name": "test",
+	}
+
+	if got := tagsToMap(tags, ignoreConfig); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got %#v, expected %#v", got, expected)
+	}
+}
+
+func TestDefaultTagsConfigMergeTags(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		DefaultConfig *DefaultTagsConfig
+		Tags          map[string]interface{}
+		Expected      map[string]interface{}
+	}{
+		{
+			Name:          "nil configuration",
+			DefaultConfig: nil,
+			Tags: map[string]interface{}{
+				"Name": "test",
 			},
-			New: map[string]interface{}{
-				"foo": "baz",
+			Expected: map[string]interface{}{
+				"Name": "test",
 			},
-			Create: map[string]string{
-				"foo": "baz",
+		},
+		{
+			Name: "no resource tags",
+			DefaultConfig: &DefaultTagsConfig{
+				Tags: map[string]string{"Environment": "production"},
 			},
-			Remove: map[string]string{
-				"foo": "bar",
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Environment": "production",
 			},
 		},
-
-		// Overlap
 		{
-			Old: map[string]interface{}{
-				"foo":   "bar",
-				"hello": "world",
+			Name: "merged tags",
+			DefaultConfig: &DefaultTagsConfig{
+				Tags: map[string]string{"Environment": "production"},
 			},
-			New: map[string]interface{}{
-				"foo":   "baz",
-				"hello": "world",
+			Tags: map[string]interface{}{
+				"Name": "test",
 			},
-			Create: map[string]string{
-				"foo": "baz",
+			Expected: map[string]interface{}{
+				"Environment": "production",
+				"Name":        "test",
 			},
-			Remove: map[string]string{
-				"foo": "bar",
+		},
+		{
+			Name: "resource tags override default tags",
+			DefaultConfig: &DefaultTagsConfig{
+				Tags: map[string]string{"Environment": "production", "Team": "core"},
+			},
+			Tags: map[string]interface{}{
+				"Environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"Environment": "staging",
+				"Team":        "core",
 			},
 		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.DefaultConfig.MergeTags(testCase.Tags)
 
-		// Remove
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSetTagsAndTagsAll(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		DefaultConfig  *DefaultTagsConfig
+		ConfiguredTags map[string]interface{}
+		AllTags        map[string]string
+		ExpectedTags   map[string]string
+	}{
 		{
-			Old: map[string]interface{}{
-				"foo": "bar",
-				"bar": "baz",
+			Name:          "nil configuration",
+			DefaultConfig: nil,
+			AllTags:       map[string]string{"Name": "test"},
+			ExpectedTags:  map[string]string{"Name": "test"},
+		},
+		{
+			Name: "default tags removed",
+			DefaultConfig: &DefaultTagsConfig{
+				Tags: map[string]string{"Environment": "production"},
 			},
-			New: map[string]interface{}{
-				"foo": "bar",
+			AllTags:      map[string]string{"Environment": "production", "Name": "test"},
+			ExpectedTags: map[string]string{"Name": "test"},
+		},
+		{
+			Name: "default tags with different value kept",
+			DefaultConfig: &DefaultTagsConfig{
+				Tags: map[string]string{"Environment": "production"},
 			},
-			Create: map[string]string{},
-			Remove: map[string]string{
-				"bar": "baz",
+			AllTags:      map[string]string{"Environment": "staging"},
+			ExpectedTags: map[string]string{"Environment": "staging"},
+		},
+		{
+			Name: "configured default tags kept",
+			DefaultConfig: &DefaultTagsConfig{
+				Tags: map[string]string{"Environment": "production"},
 			},
+			ConfiguredTags: map[string]interface{}{"Environment": "production"},
+			AllTags:        map[string]string{"Environment": "production"},
+			ExpectedTags:   map[string]string{"Environment": "production"},
 		},
 	}
 
-	for i, tc := range cases {
-		c, r := diffTags(tagsFromMap(tc.Old), tagsFromMap(tc.New))
-		cm := tagsToMap(c)
-		rm := tagsToMap(r)
-		if !reflect.DeepEqual(cm, tc.Create) {
-			t.Fatalf("%d: bad create: %#v", i, cm)
-		}
-		if !reflect.DeepEqual(rm, tc.Remove) {
-			t.Fatalf("%d: bad remove: %#v", i, rm)
+	stringMap := func(m map[string]interface{}) map[string]string {
+		result := make(map[string]string, len(m))
+		for k, v := range m {
+			result[k] = v.(string)
 		}
+		return result
 	}
-}
 
-func TestIgnoringTags(t *testing.T) {
-	var ignoredTags []*ec2.Tag
-	ignoredTags = append(ignoredTags, &ec2.Tag{
-
-		Key:   aws.String("aws:cloudformation:logical-id"),
-		Value: aws.String("foo"),
-	})
-	ignoredTags = append(ignoredTags, &ec2.Tag{
-		Key:   aws.String("aws:foo:bar"),
-		Value: aws.String("baz"),
-	})
-	for _, tag := range ignoredTags {
-		if !tagIgnored(tag) {
-			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
-		}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"tags":     tagsSchema(),
+				"tags_all": tagsSchemaTrulyComputed(),
+			}, map[string]interface{}{
+				"tags": testCase.ConfiguredTags,
+			})
+
+			if err := setTagsAndTagsAll(d, testCase.DefaultConfig, testCase.AllTags); err != nil {
+				t.Fatalf("error setting tags: %s", err)
+			}
+
+			if got := stringMap(d.Get("tags").(map[string]interface{})); !reflect.DeepEqual(got, testCase.ExpectedTags) {
+				t.Errorf("got tags %#v, expected %#v", got, testCase.ExpectedTags)
+			}
+
+			if got := stringMap(d.Get("tags_all").(map[string]interface{})); !reflect.DeepEqual(got, testCase.AllTags) {
+				t.Errorf("got tags_all %#v, expected %#v", got, testCase.AllTags)
+			}
+		})
 	}
 }
 
@@ -160,7 +296,7 @@ func TestTagsMapToHash(t *testing.T) {
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMap(*ts)
+		m := tagsToMap(*ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
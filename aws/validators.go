@@ -856,6 +856,16 @@ func validateS3BucketLifecycleTimestamp(v interface{}, k string) (ws []string, e
 	return
 }
 
+func validateUTCTimestamp(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be in RFC3339 time format %q, e.g. \"2006-01-02T15:04:05Z\": %s", k, time.RFC3339, err))
+	}
+
+	return
+}
+
 func validateS3BucketLifecycleTransitionStorageClass() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		s3.TransitionStorageClassGlacier,
@@ -2015,6 +2025,16 @@ func validateDynamoDbStreamSpec(d *schema.ResourceDiff) error {
 	return nil
 }
 
+// validateDynamoDbTableServerSideEncryption returns an error if a KMS key is
+// configured without enabling server-side encryption, as the key would be
+// ignored when creating the table.
+func validateDynamoDbTableServerSideEncryption(m map[string]interface{}) error {
+	if v, ok := m["kms_key_arn"].(string); ok && v != "" && !m["enabled"].(bool) {
+		return errors.New("server_side_encryption kms_key_arn requires enabled = true")
+	}
+	return nil
+}
+
 func validateAmazonSideAsn(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
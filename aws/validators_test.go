@@ -601,6 +601,35 @@ func TestValidateS3BucketLifecycleTimestamp(t *testing.T) {
 	}
 }
 
+func TestValidateUTCTimestamp(t *testing.T) {
+	validT := []string{
+		"2006-01-02T15:04:05Z",
+	}
+
+	for _, v := range validT {
+		_, errors := validateUTCTimestamp(v, "validT")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid timestamp: %q", v, errors)
+		}
+	}
+
+	invalidT := []string{
+		"2015-03-07 23:45:00",
+		"27-03-2019 23:45:00",
+		"Mon, 02 Jan 2006 15:04:05 -0700",
+	}
+
+	for _, v := range invalidT {
+		_, errors := validateUTCTimestamp(v, "invalidT")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid timestamp", v)
+		}
+		if !strings.Contains(errors[0].Error(), `e.g. "2006-01-02T15:04:05Z": parsing time`) {
+			t.Fatalf("%q should have an example timestamp followed by the parse error: %q", v, errors[0])
+		}
+	}
+}
+
 func TestValidateSagemakerName(t *testing.T) {
 	validNames := []string{
 		"ValidSageMakerName",
@@ -2994,3 +3023,41 @@ func TestValidateRoute53ResolverName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDynamoDbTableServerSideEncryption(t *testing.T) {
+	cases := []struct {
+		Value       map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Value: map[string]interface{}{
+				"enabled":     false,
+				"kms_key_arn": "",
+			},
+			ExpectError: false,
+		},
+		{
+			Value: map[string]interface{}{
+				"enabled":     true,
+				"kms_key_arn": "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			},
+			ExpectError: false,
+		},
+		{
+			Value: map[string]interface{}{
+				"enabled":     false,
+				"kms_key_arn": "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			},
+			ExpectError: true,
+		},
+	}
+	for _, tc := range cases {
+		err := validateDynamoDbTableServerSideEncryption(tc.Value)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected server_side_encryption %v to trigger a validation error", tc.Value)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected server_side_encryption %v to not trigger a validation error: %s", tc.Value, err)
+		}
+	}
+}